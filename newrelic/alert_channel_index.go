@@ -0,0 +1,200 @@
+package newrelic
+
+import (
+	"log"
+	"sort"
+	"sync"
+
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+// alertChannelIndex caches the alert channels of an account, including the
+// policies each channel is linked to. The API can only return channels by
+// paging through all of them, so the index is loaded once per run and kept
+// up to date as the provider links and unlinks channels.
+type alertChannelIndex struct {
+	mu       sync.Mutex
+	channels map[int]newrelic.AlertChannel
+}
+
+var (
+	alertChannelIndexesLock sync.Mutex
+	alertChannelIndexes     = map[*newrelic.Client]*alertChannelIndex{}
+)
+
+// alertChannelIndexFor returns the shared channel index for the client.
+func alertChannelIndexFor(client *newrelic.Client) *alertChannelIndex {
+	alertChannelIndexesLock.Lock()
+	defer alertChannelIndexesLock.Unlock()
+
+	index, ok := alertChannelIndexes[client]
+	if !ok {
+		index = &alertChannelIndex{}
+		alertChannelIndexes[client] = index
+	}
+
+	return index
+}
+
+// load lists the channels if the index is empty. The caller must hold mu.
+func (i *alertChannelIndex) load(client *newrelic.Client) error {
+	if i.channels != nil {
+		return nil
+	}
+
+	log.Printf("[INFO] Listing New Relic alert channels")
+
+	channels, err := client.ListAlertChannels()
+	if err != nil {
+		return err
+	}
+
+	i.channels = make(map[int]newrelic.AlertChannel, len(channels))
+	for _, channel := range channels {
+		i.channels[channel.ID] = channel
+	}
+
+	return nil
+}
+
+// Get returns the channel with the given ID, or newrelic.ErrNotFound.
+func (i *alertChannelIndex) Get(client *newrelic.Client, channelID int) (*newrelic.AlertChannel, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err := i.load(client); err != nil {
+		return nil, err
+	}
+
+	channel, ok := i.channels[channelID]
+	if !ok {
+		return nil, newrelic.ErrNotFound
+	}
+
+	channel.Links.PolicyIDs = append([]int{}, channel.Links.PolicyIDs...)
+
+	return &channel, nil
+}
+
+// PolicyChannelIDs returns the IDs of the channels linked to the policy.
+func (i *alertChannelIndex) PolicyChannelIDs(client *newrelic.Client, policyID int) ([]int, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err := i.load(client); err != nil {
+		return nil, err
+	}
+
+	channelIDs := []int{}
+
+	for _, channel := range i.channels {
+		if containsInt(channel.Links.PolicyIDs, policyID) {
+			channelIDs = append(channelIDs, channel.ID)
+		}
+	}
+
+	sort.Ints(channelIDs)
+
+	return channelIDs, nil
+}
+
+// Put records a newly created channel.
+func (i *alertChannelIndex) Put(channel newrelic.AlertChannel) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.channels != nil {
+		i.channels[channel.ID] = channel
+	}
+}
+
+// Remove forgets a deleted channel.
+func (i *alertChannelIndex) Remove(channelID int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.channels, channelID)
+}
+
+// Link records that the channels were linked to the policy.
+func (i *alertChannelIndex) Link(policyID int, channelIDs []int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, channelID := range channelIDs {
+		channel, ok := i.channels[channelID]
+		if !ok || containsInt(channel.Links.PolicyIDs, policyID) {
+			continue
+		}
+
+		channel.Links.PolicyIDs = append(append([]int{}, channel.Links.PolicyIDs...), policyID)
+		i.channels[channelID] = channel
+	}
+}
+
+// Unlink records that the channel was unlinked from the policy.
+func (i *alertChannelIndex) Unlink(policyID int, channelID int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	channel, ok := i.channels[channelID]
+	if !ok {
+		return
+	}
+
+	channel.Links.PolicyIDs = removeInt(channel.Links.PolicyIDs, policyID)
+	i.channels[channelID] = channel
+}
+
+// RemovePolicy unlinks a deleted policy from every channel.
+func (i *alertChannelIndex) RemovePolicy(policyID int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for id, channel := range i.channels {
+		if containsInt(channel.Links.PolicyIDs, policyID) {
+			channel.Links.PolicyIDs = removeInt(channel.Links.PolicyIDs, policyID)
+			i.channels[id] = channel
+		}
+	}
+}
+
+// Invalidate drops the cached channels so the next lookup lists them again.
+// It is used when a change may have only partially applied.
+func (i *alertChannelIndex) Invalidate() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.channels = nil
+}
+
+// linkAlertPolicyChannels links the channels to the policy and keeps the
+// shared channel index in sync.
+func linkAlertPolicyChannels(client *newrelic.Client, policyID int, channelIDs []int) error {
+	index := alertChannelIndexFor(client)
+
+	if err := client.UpdateAlertPolicyChannels(policyID, channelIDs); err != nil {
+		index.Invalidate()
+		return err
+	}
+
+	index.Link(policyID, channelIDs)
+
+	return nil
+}
+
+// unlinkAlertPolicyChannel unlinks the channel from the policy and keeps the
+// shared channel index in sync. newrelic.ErrNotFound is returned unchanged.
+func unlinkAlertPolicyChannel(client *newrelic.Client, policyID int, channelID int) error {
+	index := alertChannelIndexFor(client)
+
+	err := client.DeleteAlertPolicyChannel(policyID, channelID)
+	if err != nil && err != newrelic.ErrNotFound {
+		index.Invalidate()
+		return err
+	}
+
+	index.Unlink(policyID, channelID)
+
+	return err
+}
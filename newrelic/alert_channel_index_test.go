@@ -0,0 +1,82 @@
+package newrelic
+
+import (
+	"reflect"
+	"testing"
+
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func testAlertChannelIndex() *alertChannelIndex {
+	return &alertChannelIndex{
+		channels: map[int]newrelic.AlertChannel{
+			1: {ID: 1, Links: newrelic.AlertChannelLinks{PolicyIDs: []int{10, 20}}},
+			2: {ID: 2, Links: newrelic.AlertChannelLinks{PolicyIDs: []int{20}}},
+			3: {ID: 3},
+		},
+	}
+}
+
+func TestAlertChannelIndex_PolicyChannelIDs(t *testing.T) {
+	index := testAlertChannelIndex()
+
+	ids, err := index.PolicyChannelIDs(nil, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(ids, []int{1, 2}) {
+		t.Fatal(ids)
+	}
+}
+
+func TestAlertChannelIndex_Get(t *testing.T) {
+	index := testAlertChannelIndex()
+
+	channel, err := index.Get(nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if channel.ID != 1 {
+		t.Fatal(channel.ID)
+	}
+
+	if _, err := index.Get(nil, 4); err != newrelic.ErrNotFound {
+		t.Fatal(err)
+	}
+}
+
+func TestAlertChannelIndex_LinkUnlink(t *testing.T) {
+	index := testAlertChannelIndex()
+
+	index.Link(30, []int{1, 3})
+	index.Link(30, []int{1})
+	index.Unlink(20, 2)
+
+	ids, _ := index.PolicyChannelIDs(nil, 30)
+	if !reflect.DeepEqual(ids, []int{1, 3}) {
+		t.Fatal(ids)
+	}
+
+	ids, _ = index.PolicyChannelIDs(nil, 20)
+	if !reflect.DeepEqual(ids, []int{1}) {
+		t.Fatal(ids)
+	}
+}
+
+func TestAlertChannelIndex_RemovePolicy(t *testing.T) {
+	index := testAlertChannelIndex()
+
+	index.RemovePolicy(20)
+
+	ids, _ := index.PolicyChannelIDs(nil, 20)
+	if len(ids) != 0 {
+		t.Fatal(ids)
+	}
+
+	ids, _ = index.PolicyChannelIDs(nil, 10)
+	if !reflect.DeepEqual(ids, []int{1}) {
+		t.Fatal(ids)
+	}
+}
@@ -0,0 +1,100 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+// applicationCache shares application queries between the data sources
+// read in a single run, so identical lookups only page through the API once.
+type applicationCache struct {
+	mu      sync.Mutex
+	entries map[string]*applicationCacheEntry
+}
+
+type applicationCacheEntry struct {
+	mu           sync.Mutex
+	loaded       bool
+	applications []newrelic.Application
+}
+
+var (
+	applicationCachesLock sync.Mutex
+	applicationCaches     = map[*newrelic.Client]*applicationCache{}
+)
+
+// applicationCacheFor returns the shared application cache for the client.
+func applicationCacheFor(client *newrelic.Client) *applicationCache {
+	applicationCachesLock.Lock()
+	defer applicationCachesLock.Unlock()
+
+	cache, ok := applicationCaches[client]
+	if !ok {
+		cache = &applicationCache{
+			entries: map[string]*applicationCacheEntry{},
+		}
+		applicationCaches[client] = cache
+	}
+
+	return cache
+}
+
+func (c *applicationCache) entry(key string) *applicationCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &applicationCacheEntry{}
+		c.entries[key] = entry
+	}
+
+	return entry
+}
+
+// Query returns the applications matching the filters, querying the API only
+// the first time a given set of filters is used. Failed queries are not cached.
+func (c *applicationCache) Query(client *newrelic.Client, filters newrelic.ApplicationsFilters) ([]newrelic.Application, error) {
+	key := applicationsFiltersKey(filters)
+	entry := c.entry(key)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if !entry.loaded {
+		log.Printf("[INFO] Querying New Relic applications (%s)", key)
+
+		applications, err := client.QueryApplications(filters)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.applications = applications
+		entry.loaded = true
+	}
+
+	return entry.applications, nil
+}
+
+// Invalidate drops all cached queries, e.g. after an application was changed.
+func (c *applicationCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*applicationCacheEntry{}
+}
+
+func applicationsFiltersKey(filters newrelic.ApplicationsFilters) string {
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	return fmt.Sprintf("name=%q host=%q ids=%v language=%q",
+		deref(filters.Name), deref(filters.Host), filters.IDs, deref(filters.Language))
+}
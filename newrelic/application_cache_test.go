@@ -0,0 +1,27 @@
+package newrelic
+
+import (
+	"testing"
+
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func TestApplicationsFiltersKey(t *testing.T) {
+	name := "foo"
+	language := "java"
+
+	key := applicationsFiltersKey(newrelic.ApplicationsFilters{
+		Name:     &name,
+		IDs:      []int{1, 2},
+		Language: &language,
+	})
+
+	expected := `name="foo" host="" ids=[1 2] language="java"`
+	if key != expected {
+		t.Fatalf("expected %s, got %s", expected, key)
+	}
+
+	if applicationsFiltersKey(newrelic.ApplicationsFilters{}) == key {
+		t.Fatal("expected different filters to have different keys")
+	}
+}
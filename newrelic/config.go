@@ -9,12 +9,20 @@ import (
 
 // Config contains New Relic provider settings
 type Config struct {
-	APIKey string
-	APIURL string
+	APIKey           string
+	APIURL           string
+	SyntheticsAPIURL string
 }
 
-// Client returns a new client for accessing New Relic
-func (c *Config) Client() (*newrelic.Client, error) {
+// ProviderConfig contains the clients passed to resources as meta. The
+// Synthetics API lives on its own host, so it gets its own client.
+type ProviderConfig struct {
+	Client           *newrelic.Client
+	SyntheticsClient *newrelic.Client
+}
+
+// Client returns new clients for accessing New Relic
+func (c *Config) Client() (*ProviderConfig, error) {
 	nrConfig := newrelic.Config{
 		APIKey:  c.APIKey,
 		Debug:   logging.IsDebugOrHigher(),
@@ -23,7 +31,14 @@ func (c *Config) Client() (*newrelic.Client, error) {
 
 	client := newrelic.New(nrConfig)
 
+	syntheticsConfig := nrConfig
+	syntheticsConfig.BaseURL = c.SyntheticsAPIURL
+	syntheticsClient := newrelic.New(syntheticsConfig)
+
 	log.Printf("[INFO] New Relic client configured")
 
-	return &client, nil
+	return &ProviderConfig{
+		Client:           &client,
+		SyntheticsClient: &syntheticsClient,
+	}, nil
 }
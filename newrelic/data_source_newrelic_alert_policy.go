@@ -0,0 +1,78 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicAlertPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicAlertPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"incident_preference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicAlertPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading New Relic alert policies named %s", name)
+
+	policies, err := client.ListAlertPoliciesByName(name)
+	if err != nil {
+		return err
+	}
+
+	// The API filter is a partial match, so only keep exact matches.
+	var matches []newrelic.AlertPolicy
+	for _, p := range policies {
+		if p.Name == name {
+			matches = append(matches, p)
+		}
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("The name '%s' does not match any New Relic alert policies.", name)
+	}
+
+	if len(matches) > 1 {
+		ids := make([]int, len(matches))
+		for i, p := range matches {
+			ids[i] = p.ID
+		}
+		return fmt.Errorf("The name '%s' matches %d New Relic alert policies (IDs %v), it must be unique.", name, len(matches), ids)
+	}
+
+	policy := matches[0]
+
+	d.SetId(strconv.Itoa(policy.ID))
+	d.Set("name", policy.Name)
+	d.Set("incident_preference", policy.IncidentPreference)
+	d.Set("created_at", policy.CreatedAt)
+	d.Set("updated_at", policy.UpdatedAt)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNewRelicAlertPolicyDataSource_Basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicAlertPolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicAlertPolicyDataSource("data.newrelic_alert_policy.policy"),
+					resource.TestCheckResourceAttr(
+						"data.newrelic_alert_policy.policy", "incident_preference", "PER_CONDITION"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicAlertPolicyDataSource(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r := s.RootModule().Resources[n]
+		a := r.Primary.Attributes
+
+		if a["id"] == "" {
+			return fmt.Errorf("Expected to get an alert policy from New Relic")
+		}
+
+		expected := s.RootModule().Resources["newrelic_alert_policy.foo"].Primary.ID
+		if a["id"] != expected {
+			return fmt.Errorf("Expected the alert policy ID to be: %s, but got: %s", expected, a["id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccNewRelicAlertPolicyDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+  name                = "tf-test-%s"
+  incident_preference = "PER_CONDITION"
+}
+
+data "newrelic_alert_policy" "policy" {
+  name = "${newrelic_alert_policy.foo.name}"
+}
+`, rName)
+}
@@ -0,0 +1,257 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicAlertViolations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicAlertViolationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"entity_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"critical", "warning"}, false),
+			},
+			"include_incidents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
+			"violations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"condition_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"condition_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"incident_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"entity_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"entity_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entity_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opened_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"incidents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"incident_preference": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"violation_ids": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+							Computed: true,
+						},
+						"opened_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicAlertViolationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	log.Printf("[INFO] Reading New Relic open alert violations")
+
+	violations, err := client.QueryAlertViolations(newrelic.AlertViolationsFilters{
+		OnlyOpen: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	matches := filterAlertViolations(violations, d.Get("policy_id").(int), d.Get("entity_id").(int), d.Get("priority").(string))
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].ID < matches[j].ID
+	})
+
+	ids := make([]int, len(matches))
+	list := make([]map[string]interface{}, len(matches))
+
+	for i, v := range matches {
+		ids[i] = v.ID
+		list[i] = map[string]interface{}{
+			"id":             v.ID,
+			"label":          v.Label,
+			"policy_id":      v.Links.PolicyID,
+			"condition_id":   v.Links.ConditionID,
+			"condition_name": v.ConditionName,
+			"incident_id":    v.Links.IncidentID,
+			"entity_id":      v.Entity.ID,
+			"entity_name":    v.Entity.Name,
+			"entity_type":    v.Entity.Type,
+			"priority":       strings.ToLower(v.Priority),
+			"opened_at":      formatEpochMillis(v.OpenedAt),
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(serializeIDs(ids))))
+	d.Set("ids", ids)
+
+	if err := d.Set("violations", list); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting alert violations: %#v", err)
+	}
+
+	if !d.Get("include_incidents").(bool) {
+		return nil
+	}
+
+	log.Printf("[INFO] Reading New Relic open alert incidents")
+
+	incidents, err := client.QueryAlertIncidents(newrelic.AlertIncidentsFilters{
+		OnlyOpen: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	incidentList := []map[string]interface{}{}
+
+	for _, incident := range filterAlertIncidents(incidents, ids) {
+		incidentList = append(incidentList, map[string]interface{}{
+			"id":                  incident.ID,
+			"policy_id":           incident.Links.PolicyID,
+			"incident_preference": incident.IncidentPreference,
+			"violation_ids":       incident.Links.Violations,
+			"opened_at":           formatEpochMillis(incident.OpenedAt),
+		})
+	}
+
+	if err := d.Set("incidents", incidentList); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting alert incidents: %#v", err)
+	}
+
+	return nil
+}
+
+// filterAlertViolations returns the violations matching the policy, entity
+// and priority, where a zero value matches any.
+func filterAlertViolations(violations []newrelic.AlertViolation, policyID int, entityID int, priority string) []newrelic.AlertViolation {
+	matches := []newrelic.AlertViolation{}
+
+	for _, v := range violations {
+		if policyID != 0 && v.Links.PolicyID != policyID {
+			continue
+		}
+
+		if entityID != 0 && v.Entity.ID != entityID {
+			continue
+		}
+
+		// The API reports priorities capitalized, e.g. "Critical".
+		if priority != "" && !strings.EqualFold(v.Priority, priority) {
+			continue
+		}
+
+		matches = append(matches, v)
+	}
+
+	return matches
+}
+
+// filterAlertIncidents returns the incidents that contain any of the
+// violations, sorted by ID.
+func filterAlertIncidents(incidents []newrelic.AlertIncident, violationIDs []int) []newrelic.AlertIncident {
+	matches := []newrelic.AlertIncident{}
+
+	for _, incident := range incidents {
+		if containsAnyInt(incident.Links.Violations, violationIDs) {
+			matches = append(matches, incident)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].ID < matches[j].ID
+	})
+
+	return matches
+}
+
+// formatEpochMillis formats a timestamp in milliseconds since the epoch as
+// an RFC 3339 string in UTC.
+func formatEpochMillis(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+
+	return time.Unix(0, millis*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
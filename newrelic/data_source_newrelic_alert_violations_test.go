@@ -0,0 +1,91 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func TestFilterAlertViolations_Basic(t *testing.T) {
+	violations := []newrelic.AlertViolation{
+		{ID: 1, Priority: "Critical", Entity: newrelic.AlertViolationEntity{ID: 10}, Links: newrelic.AlertViolationLinks{PolicyID: 100}},
+		{ID: 2, Priority: "Warning", Entity: newrelic.AlertViolationEntity{ID: 10}, Links: newrelic.AlertViolationLinks{PolicyID: 100}},
+		{ID: 3, Priority: "Critical", Entity: newrelic.AlertViolationEntity{ID: 20}, Links: newrelic.AlertViolationLinks{PolicyID: 200}},
+	}
+
+	cases := []struct {
+		policyID int
+		entityID int
+		priority string
+		expected []int
+	}{
+		{0, 0, "", []int{1, 2, 3}},
+		{100, 0, "", []int{1, 2}},
+		{0, 20, "", []int{3}},
+		{100, 0, "critical", []int{1}},
+		{200, 10, "", []int{}},
+	}
+
+	for _, tc := range cases {
+		matches := filterAlertViolations(violations, tc.policyID, tc.entityID, tc.priority)
+
+		ids := []int{}
+		for _, v := range matches {
+			ids = append(ids, v.ID)
+		}
+
+		if !sameIntSet(ids, tc.expected) {
+			t.Fatalf("policy %d, entity %d, priority %q: expected %v, got %v", tc.policyID, tc.entityID, tc.priority, tc.expected, ids)
+		}
+	}
+}
+
+func TestFilterAlertIncidents_Basic(t *testing.T) {
+	incidents := []newrelic.AlertIncident{
+		{ID: 2, Links: newrelic.AlertIncidentLinks{Violations: []int{3}}},
+		{ID: 1, Links: newrelic.AlertIncidentLinks{Violations: []int{1, 2}}},
+		{ID: 3, Links: newrelic.AlertIncidentLinks{Violations: []int{4}}},
+	}
+
+	matches := filterAlertIncidents(incidents, []int{2, 3})
+
+	if len(matches) != 2 || matches[0].ID != 1 || matches[1].ID != 2 {
+		t.Fatalf("expected incidents 1 and 2, got %v", matches)
+	}
+}
+
+func TestAccNewRelicAlertViolations_Basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicAlertViolationsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.newrelic_alert_violations.open", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.newrelic_alert_violations.open", "violations.#", "0"),
+					resource.TestCheckResourceAttr("data.newrelic_alert_violations.open", "incidents.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// A new policy without conditions cannot have open violations.
+func testAccNewRelicAlertViolationsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+  name = "tf-test-%s"
+}
+
+data "newrelic_alert_violations" "open" {
+  policy_id         = "${newrelic_alert_policy.foo.id}"
+  priority          = "critical"
+  include_incidents = true
+}
+`, rName)
+}
@@ -14,9 +14,36 @@ func dataSourceNewRelicApplication() *schema.Resource {
 		Read: dataSourceNewRelicApplicationRead,
 
 		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reporting": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_reported_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"alert_policy_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
 			},
 			"instance_ids": {
 				Type:     schema.TypeList,
@@ -28,38 +55,204 @@ func dataSourceNewRelicApplication() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 				Computed: true,
 			},
+			"settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_apdex_threshold": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"end_user_apdex_threshold": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"enable_real_user_monitoring": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"application_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"response_time": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"throughput": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"error_rate": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"apdex_target": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"apdex_score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"host_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"concurrent_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"end_user_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"response_time": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"throughput": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"apdex_target": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"apdex_score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceNewRelicApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
+
+	rawID, hasID := d.GetOk("id")
+	name, hasName := d.GetOk("name")
+
+	if hasID == hasName {
+		return fmt.Errorf("Exactly one of 'id' or 'name' must be set to look up a New Relic application.")
+	}
+
+	filters := newrelic.ApplicationsFilters{}
+
+	if attr, ok := d.GetOk("language"); ok {
+		language := attr.(string)
+		filters.Language = &language
+	}
+
+	var id int
+	if hasID {
+		var err error
+		id, err = strconv.Atoi(rawID.(string))
+		if err != nil {
+			return fmt.Errorf("The id '%s' is not a valid New Relic application ID.", rawID)
+		}
+
+		filters.IDs = []int{id}
+	} else {
+		n := name.(string)
+		filters.Name = &n
+	}
 
 	log.Printf("[INFO] Reading New Relic applications")
 
-	applications, err := client.ListApplications()
+	applications, err := applicationCacheFor(client).Query(client, filters)
 	if err != nil {
 		return err
 	}
 
+	// The name filter is a partial match, so only keep an exact match.
 	var application *newrelic.Application
-	name := d.Get("name").(string)
-
 	for _, a := range applications {
-		if a.Name == name {
+		if (hasID && a.ID == id) || (hasName && a.Name == name.(string)) {
 			application = &a
 			break
 		}
 	}
 
 	if application == nil {
+		if hasID {
+			return fmt.Errorf("The id '%d' does not match any New Relic applications.", id)
+		}
+
 		return fmt.Errorf("The name '%s' does not match any New Relic applications.", name)
 	}
 
+	return readApplicationStruct(application, d)
+}
+
+func readApplicationStruct(application *newrelic.Application, d *schema.ResourceData) error {
 	d.SetId(strconv.Itoa(application.ID))
 	d.Set("name", application.Name)
+	d.Set("language", application.Language)
+	d.Set("health_status", application.HealthStatus)
+	d.Set("reporting", application.Reporting)
+	d.Set("last_reported_at", application.LastReportedAt)
+	d.Set("alert_policy_id", application.Links.AlertPolicyID)
 	d.Set("instance_ids", application.Links.InstanceIDs)
 	d.Set("host_ids", application.Links.HostIDs)
 
+	settings := []map[string]interface{}{
+		{
+			"app_apdex_threshold":         application.Settings.AppApdexThreshold,
+			"end_user_apdex_threshold":    application.Settings.EndUserApdexThreshold,
+			"enable_real_user_monitoring": application.Settings.EnableRealUserMonitoring,
+		},
+	}
+
+	if err := d.Set("settings", settings); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting application settings: %#v", err)
+	}
+
+	summary := []map[string]interface{}{
+		{
+			"response_time":             application.Summary.ResponseTime,
+			"throughput":                application.Summary.Throughput,
+			"error_rate":                application.Summary.ErrorRate,
+			"apdex_target":              application.Summary.ApdexTarget,
+			"apdex_score":               application.Summary.ApdexScore,
+			"host_count":                application.Summary.HostCount,
+			"instance_count":            application.Summary.InstanceCount,
+			"concurrent_instance_count": application.Summary.ConcurrentInstanceCount,
+		},
+	}
+
+	if err := d.Set("application_summary", summary); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting application summary: %#v", err)
+	}
+
+	endUserSummary := []map[string]interface{}{
+		{
+			"response_time": application.EndUserSummary.ResponseTime,
+			"throughput":    application.EndUserSummary.Throughput,
+			"apdex_target":  application.EndUserSummary.ApdexTarget,
+			"apdex_score":   application.EndUserSummary.ApdexScore,
+		},
+	}
+
+	if err := d.Set("end_user_summary", endUserSummary); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting application end user summary: %#v", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,208 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func applicationHostSchema(extra map[string]*schema.Schema) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"hostname": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"language": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"health_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"server_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"response_time": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+		"throughput": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+		"error_rate": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+		"apdex_target": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+		"apdex_score": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+	}
+
+	for k, v := range extra {
+		s[k] = v
+	}
+
+	return &schema.Resource{Schema: s}
+}
+
+func dataSourceNewRelicApplicationHosts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicApplicationHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"host_ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
+			"hostnames": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: applicationHostSchema(map[string]*schema.Schema{
+					"instance_ids": {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeInt},
+						Computed: true,
+					},
+				}),
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: applicationHostSchema(map[string]*schema.Schema{
+					"host_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"port": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				}),
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicApplicationHostsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	applicationID := d.Get("application_id").(int)
+
+	log.Printf("[INFO] Reading New Relic hosts of application %d", applicationID)
+
+	hosts, err := client.ListApplicationHosts(applicationID)
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			return fmt.Errorf("The ID '%d' does not match any New Relic applications.", applicationID)
+		}
+
+		return err
+	}
+
+	log.Printf("[INFO] Reading New Relic instances of application %d", applicationID)
+
+	instances, err := client.ListApplicationInstances(applicationID)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(hosts, func(i, j int) bool {
+		if hosts[i].Host == hosts[j].Host {
+			return hosts[i].ID < hosts[j].ID
+		}
+		return hosts[i].Host < hosts[j].Host
+	})
+
+	sort.Slice(instances, func(i, j int) bool {
+		if instances[i].Host == instances[j].Host {
+			return instances[i].ID < instances[j].ID
+		}
+		return instances[i].Host < instances[j].Host
+	})
+
+	hostIDs := make([]int, len(hosts))
+	hostnames := make([]string, len(hosts))
+	hostList := make([]map[string]interface{}, len(hosts))
+
+	for i, h := range hosts {
+		hostIDs[i] = h.ID
+		hostnames[i] = h.Host
+
+		m := flattenApplicationHost(h.ID, h.Host, h.Language, h.HealthStatus, h.Links.Server, h.Summary)
+		m["instance_ids"] = h.Links.ApplicationInstances
+		hostList[i] = m
+	}
+
+	instanceIDs := make([]int, len(instances))
+	instanceList := make([]map[string]interface{}, len(instances))
+
+	for i, inst := range instances {
+		instanceIDs[i] = inst.ID
+
+		m := flattenApplicationHost(inst.ID, inst.Host, inst.Language, inst.HealthStatus, inst.Links.Server, inst.Summary)
+		m["host_id"] = inst.Links.ApplicationHost
+		m["port"] = inst.Port
+		instanceList[i] = m
+	}
+
+	d.SetId(strconv.Itoa(applicationID))
+	d.Set("host_ids", hostIDs)
+	d.Set("hostnames", hostnames)
+	d.Set("instance_ids", instanceIDs)
+
+	if err := d.Set("hosts", hostList); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting application hosts: %#v", err)
+	}
+
+	if err := d.Set("instances", instanceList); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting application instances: %#v", err)
+	}
+
+	return nil
+}
+
+func flattenApplicationHost(id int, hostname, language, healthStatus string, serverID int, summary newrelic.ApplicationSummary) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            id,
+		"hostname":      hostname,
+		"language":      language,
+		"health_status": healthStatus,
+		"server_id":     serverID,
+		"response_time": summary.ResponseTime,
+		"throughput":    summary.Throughput,
+		"error_rate":    summary.ErrorRate,
+		"apdex_target":  summary.ApdexTarget,
+		"apdex_score":   summary.ApdexScore,
+	}
+}
@@ -0,0 +1,40 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicApplicationHosts_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicApplicationHostsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.newrelic_application_hosts.hosts", "application_id",
+						"data.newrelic_application.app", "id"),
+					resource.TestCheckResourceAttrSet("data.newrelic_application_hosts.hosts", "hosts.0.hostname"),
+					resource.TestCheckResourceAttrSet("data.newrelic_application_hosts.hosts", "instances.0.host_id"),
+				),
+			},
+		},
+	})
+}
+
+// The test application for this data source is created in provider_test.go
+func testAccNewRelicApplicationHostsConfig() string {
+	return fmt.Sprintf(`
+data "newrelic_application" "app" {
+	name = "%s"
+}
+
+data "newrelic_application_hosts" "hosts" {
+	application_id = "${data.newrelic_application.app.id}"
+}
+`, testAccExpectedApplicationName)
+}
@@ -17,6 +17,27 @@ func TestAccNewRelicApplication_Basic(t *testing.T) {
 				Config: testAccNewRelicApplicationConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccNewRelicApplication("data.newrelic_application.app"),
+					resource.TestCheckResourceAttrSet("data.newrelic_application.app", "language"),
+					resource.TestCheckResourceAttr("data.newrelic_application.app", "settings.#", "1"),
+					resource.TestCheckResourceAttr("data.newrelic_application.app", "application_summary.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicApplication_ByID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicApplicationConfigByID(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicApplication("data.newrelic_application.by_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.newrelic_application.by_id", "id",
+						"data.newrelic_application.app", "id"),
 				),
 			},
 		},
@@ -48,3 +69,15 @@ data "newrelic_application" "app" {
 }
 `, testAccExpectedApplicationName)
 }
+
+func testAccNewRelicApplicationConfigByID() string {
+	return fmt.Sprintf(`
+data "newrelic_application" "app" {
+	name = "%s"
+}
+
+data "newrelic_application" "by_id" {
+	id = "${data.newrelic_application.app.id}"
+}
+`, testAccExpectedApplicationName)
+}
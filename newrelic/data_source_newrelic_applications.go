@@ -0,0 +1,200 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicApplications() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicApplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reporting_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"green", "orange", "red", "gray", "unknown"}, false),
+			},
+			"host_ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reporting": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicApplicationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	filters := newrelic.ApplicationsFilters{}
+
+	if attr, ok := d.GetOk("language"); ok {
+		language := attr.(string)
+		filters.Language = &language
+	}
+
+	if attr, ok := d.GetOk("label"); ok {
+		key := attr.(string)
+
+		log.Printf("[INFO] Reading New Relic label %s", key)
+
+		label, err := labelIndexFor(client).Get(client, key)
+		if err != nil {
+			if err == newrelic.ErrNotFound {
+				return fmt.Errorf("The label '%s' does not match any New Relic labels.", key)
+			}
+
+			return err
+		}
+
+		// An empty ID filter would match every application.
+		if len(label.Links.Applications) == 0 {
+			return setApplicationsList(nil, d)
+		}
+
+		filters.IDs = label.Links.Applications
+	}
+
+	log.Printf("[INFO] Reading New Relic applications")
+
+	applications, err := applicationCacheFor(client).Query(client, filters)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if attr, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(attr.(string))
+	}
+
+	reportingOnly := d.Get("reporting_only").(bool)
+	healthStatus := d.Get("health_status").(string)
+
+	var hostIDs []int
+	for _, hostID := range d.Get("host_ids").([]interface{}) {
+		hostIDs = append(hostIDs, hostID.(int))
+	}
+
+	matches := []newrelic.Application{}
+
+	for _, a := range applications {
+		if nameRegex != nil && !nameRegex.MatchString(a.Name) {
+			continue
+		}
+
+		if reportingOnly && !a.Reporting {
+			continue
+		}
+
+		if healthStatus != "" && a.HealthStatus != healthStatus {
+			continue
+		}
+
+		if len(hostIDs) > 0 && !containsAnyInt(a.Links.HostIDs, hostIDs) {
+			continue
+		}
+
+		matches = append(matches, a)
+	}
+
+	return setApplicationsList(matches, d)
+}
+
+func setApplicationsList(applications []newrelic.Application, d *schema.ResourceData) error {
+	sort.Slice(applications, func(i, j int) bool {
+		if applications[i].Name == applications[j].Name {
+			return applications[i].ID < applications[j].ID
+		}
+		return applications[i].Name < applications[j].Name
+	})
+
+	ids := make([]int, len(applications))
+	names := make([]string, len(applications))
+	list := make([]map[string]interface{}, len(applications))
+
+	for i, a := range applications {
+		ids[i] = a.ID
+		names[i] = a.Name
+		list[i] = map[string]interface{}{
+			"id":            a.ID,
+			"name":          a.Name,
+			"language":      a.Language,
+			"health_status": a.HealthStatus,
+			"reporting":     a.Reporting,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(serializeIDs(ids))))
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	if err := d.Set("applications", list); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting applications: %#v", err)
+	}
+
+	return nil
+}
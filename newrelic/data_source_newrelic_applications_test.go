@@ -0,0 +1,35 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicApplications_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicApplicationsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.newrelic_applications.apps", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.newrelic_applications.apps", "names.0", testAccExpectedApplicationName),
+					resource.TestCheckResourceAttr("data.newrelic_applications.apps", "applications.0.language", "go"),
+				),
+			},
+		},
+	})
+}
+
+// The test application for this data source is created in provider_test.go
+func testAccNewRelicApplicationsConfig() string {
+	return fmt.Sprintf(`
+data "newrelic_applications" "apps" {
+	name_regex = "^%s$"
+	language   = "go"
+}
+`, testAccExpectedApplicationName)
+}
@@ -0,0 +1,66 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicBrowserApplication() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicBrowserApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"browser_monitoring_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"loader_script": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicBrowserApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading New Relic browser applications named %s", name)
+
+	applications, err := client.QueryBrowserApplications(newrelic.BrowserApplicationsFilters{
+		Name: &name,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The API filter is a partial match, so only keep an exact match.
+	var application *newrelic.BrowserApplication
+	for _, a := range applications {
+		if a.Name == name {
+			application = &a
+			break
+		}
+	}
+
+	if application == nil {
+		return fmt.Errorf("The name '%s' does not match any New Relic browser applications.", name)
+	}
+
+	d.SetId(strconv.Itoa(application.ID))
+	d.Set("name", application.Name)
+	d.Set("browser_monitoring_key", application.BrowserMonitoringKey)
+	d.Set("loader_script", application.LoaderScript)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicBrowserApplication_Basic(t *testing.T) {
+	name := os.Getenv("NEWRELIC_BROWSER_APPLICATION_NAME")
+	if name == "" {
+		t.Skip("NEWRELIC_BROWSER_APPLICATION_NAME must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicBrowserApplicationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.newrelic_browser_application.app", "id"),
+					resource.TestCheckResourceAttr("data.newrelic_browser_application.app", "name", name),
+					resource.TestCheckResourceAttrSet("data.newrelic_browser_application.app", "browser_monitoring_key"),
+				),
+			},
+		},
+	})
+}
+
+// Browser applications are created by the browser agent, so this test
+// requires an existing browser application in the account.
+func testAccNewRelicBrowserApplicationConfig(name string) string {
+	return fmt.Sprintf(`
+data "newrelic_browser_application" "app" {
+	name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,90 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicKeyTransaction() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicKeyTransactionRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"transaction_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"application_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reporting": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"apdex_target": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicKeyTransactionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading New Relic key transactions named %s", name)
+
+	transactions, err := client.QueryKeyTransactions(newrelic.KeyTransactionsFilters{
+		Name: &name,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The API filter is a partial match, so only keep exact matches.
+	var matches []newrelic.KeyTransaction
+	for _, t := range transactions {
+		if t.Name == name {
+			matches = append(matches, t)
+		}
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("The name '%s' does not match any New Relic key transactions.", name)
+	}
+
+	if len(matches) > 1 {
+		ids := make([]int, len(matches))
+		for i, t := range matches {
+			ids[i] = t.ID
+		}
+		return fmt.Errorf("The name '%s' matches %d New Relic key transactions (IDs %v), it must be unique.", name, len(matches), ids)
+	}
+
+	transaction := matches[0]
+
+	d.SetId(strconv.Itoa(transaction.ID))
+	d.Set("name", transaction.Name)
+	d.Set("transaction_name", transaction.TransactionName)
+	d.Set("application_id", transaction.Links.Application)
+	d.Set("health_status", transaction.HealthStatus)
+	d.Set("reporting", transaction.Reporting)
+	d.Set("apdex_target", transaction.Summary.ApdexTarget)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNewRelicKeyTransaction_Basic(t *testing.T) {
+	name := os.Getenv("NEWRELIC_KEY_TRANSACTION_NAME")
+	if name == "" {
+		t.Skip("NEWRELIC_KEY_TRANSACTION_NAME must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicKeyTransactionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicKeyTransaction("data.newrelic_key_transaction.txn", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicKeyTransaction(n string, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r := s.RootModule().Resources[n]
+		a := r.Primary.Attributes
+
+		if a["id"] == "" {
+			return fmt.Errorf("Expected to get a key transaction from New Relic")
+		}
+
+		if a["name"] != name {
+			return fmt.Errorf("Expected the key transaction name to be: %s, but got: %s", name, a["name"])
+		}
+
+		if a["application_id"] == "" || a["application_id"] == "0" {
+			return fmt.Errorf("Expected the key transaction to be linked to an application")
+		}
+
+		return nil
+	}
+}
+
+// Key transactions cannot be created through the REST API, so this test
+// requires an existing key transaction in the account.
+func testAccNewRelicKeyTransactionConfig(name string) string {
+	return fmt.Sprintf(`
+data "newrelic_key_transaction" "txn" {
+	name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,65 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicLabel() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicLabelRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"application_ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
+			"server_ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicLabelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	key := d.Get("key").(string)
+
+	log.Printf("[INFO] Reading New Relic label %s", key)
+
+	label, err := labelIndexFor(client).Get(client, key)
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			return fmt.Errorf("The key '%s' does not match any New Relic labels.", key)
+		}
+
+		return err
+	}
+
+	d.SetId(label.Key)
+	d.Set("category", label.Category)
+	d.Set("name", label.Name)
+	d.Set("application_ids", label.Links.Applications)
+	d.Set("server_ids", label.Links.Servers)
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicMetricData() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicMetricDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"component_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"window": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(1, 60*24*7),
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"timeslice_values": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+				Computed: true,
+			},
+			"sample_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"max": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"average": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"p50": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"p90": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"p95": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"p99": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicMetricDataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	applicationID, hasApplication := d.GetOk("application_id")
+	componentID, hasComponent := d.GetOk("component_id")
+
+	if hasApplication == hasComponent {
+		return fmt.Errorf("Exactly one of 'application_id' or 'component_id' must be set to read New Relic metric data.")
+	}
+
+	metricName := d.Get("metric_name").(string)
+	valueName := d.Get("value_name").(string)
+
+	to := time.Now()
+	from := to.Add(-time.Duration(d.Get("window").(int)) * time.Minute)
+
+	params := newrelic.MetricDataParams{
+		Names:  []string{metricName},
+		Values: []string{valueName},
+		From:   &from,
+		To:     &to,
+		Period: d.Get("period").(int),
+	}
+
+	var id string
+	var metrics []newrelic.Metric
+	var err error
+
+	if hasApplication {
+		id = fmt.Sprintf("applications/%d/%s/%s", applicationID, metricName, valueName)
+
+		log.Printf("[INFO] Reading New Relic metric data for application %d", applicationID)
+
+		metrics, err = client.QueryApplicationMetricData(applicationID.(int), params)
+	} else {
+		id = fmt.Sprintf("components/%d/%s/%s", componentID, metricName, valueName)
+
+		log.Printf("[INFO] Reading New Relic metric data for component %d", componentID)
+
+		metrics, err = client.QueryComponentMetricData(componentID.(int), params)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	values := metricTimesliceValues(metrics, metricName, valueName)
+	if len(values) == 0 {
+		return fmt.Errorf("No data found for metric '%s' value '%s' in the last %d minutes.", metricName, valueName, d.Get("window").(int))
+	}
+
+	stats := computeMetricStats(values)
+
+	d.SetId(id)
+	d.Set("timeslice_values", values)
+	d.Set("sample_count", len(values))
+	d.Set("min", stats.Min)
+	d.Set("max", stats.Max)
+	d.Set("average", stats.Average)
+	d.Set("p50", stats.Percentile(50))
+	d.Set("p90", stats.Percentile(90))
+	d.Set("p95", stats.Percentile(95))
+	d.Set("p99", stats.Percentile(99))
+
+	return nil
+}
+
+// metricTimesliceValues returns the values of a metric in timeslice order,
+// skipping timeslices without a numeric value.
+func metricTimesliceValues(metrics []newrelic.Metric, metricName string, valueName string) []float64 {
+	values := []float64{}
+
+	for _, metric := range metrics {
+		if metric.Name != metricName {
+			continue
+		}
+
+		for _, timeslice := range metric.Timeslices {
+			if v, ok := timeslice.Values[valueName].(float64); ok {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return values
+}
+
+type metricStats struct {
+	Min     float64
+	Max     float64
+	Average float64
+	sorted  []float64
+}
+
+func computeMetricStats(values []float64) *metricStats {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+
+	return &metricStats{
+		Min:     sorted[0],
+		Max:     sorted[len(sorted)-1],
+		Average: sum / float64(len(sorted)),
+		sorted:  sorted,
+	}
+}
+
+// Percentile returns the p-th percentile, interpolating linearly between the
+// closest ranks.
+func (s *metricStats) Percentile(p float64) float64 {
+	rank := p / 100 * float64(len(s.sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	if lower == upper {
+		return s.sorted[lower]
+	}
+
+	return s.sorted[lower] + (s.sorted[upper]-s.sorted[lower])*(rank-float64(lower))
+}
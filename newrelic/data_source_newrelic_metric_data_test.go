@@ -0,0 +1,87 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func TestComputeMetricStats_Basic(t *testing.T) {
+	stats := computeMetricStats([]float64{4, 1, 3, 2, 5})
+
+	if stats.Min != 1 || stats.Max != 5 || stats.Average != 3 {
+		t.Fatal(stats)
+	}
+
+	cases := map[float64]float64{
+		0:   1,
+		50:  3,
+		90:  4.6,
+		100: 5,
+	}
+
+	for p, expected := range cases {
+		if v := stats.Percentile(p); v < expected-1e-9 || v > expected+1e-9 {
+			t.Fatalf("expected p%v to be %v, got %v", p, expected, v)
+		}
+	}
+}
+
+func TestMetricTimesliceValues_Basic(t *testing.T) {
+	metrics := []newrelic.Metric{
+		{
+			Name: "HttpDispatcher",
+			Timeslices: []newrelic.MetricTimeslice{
+				{Values: map[string]interface{}{"average_response_time": 1.5}},
+				{Values: map[string]interface{}{"call_count": 10.0}},
+				{Values: map[string]interface{}{"average_response_time": 2.5}},
+			},
+		},
+		{
+			Name: "Apdex",
+			Timeslices: []newrelic.MetricTimeslice{
+				{Values: map[string]interface{}{"average_response_time": 9.0}},
+			},
+		},
+	}
+
+	values := metricTimesliceValues(metrics, "HttpDispatcher", "average_response_time")
+
+	if len(values) != 2 || values[0] != 1.5 || values[1] != 2.5 {
+		t.Fatal(values)
+	}
+}
+
+func TestAccNewRelicMetricData_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicMetricDataConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.newrelic_metric_data.calls", "sample_count"),
+					resource.TestCheckResourceAttrSet("data.newrelic_metric_data.calls", "p95"),
+				),
+			},
+		},
+	})
+}
+
+// The test application for this data source is created in provider_test.go
+func testAccNewRelicMetricDataConfig() string {
+	return fmt.Sprintf(`
+data "newrelic_application" "app" {
+	name = "%s"
+}
+
+data "newrelic_metric_data" "calls" {
+	application_id = "${data.newrelic_application.app.id}"
+	metric_name    = "Agent/MetricsReported/count"
+	value_name     = "call_count"
+	window         = 30
+}
+`, testAccExpectedApplicationName)
+}
@@ -0,0 +1,100 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicMobileApplication() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicMobileApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reporting": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"crash_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"supports_crash_data": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"unresolved_crash_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"crash_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"crash_rate": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicMobileApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	log.Printf("[INFO] Reading New Relic mobile applications")
+
+	applications, err := client.ListMobileApplications()
+	if err != nil {
+		return err
+	}
+
+	var application *newrelic.MobileApplication
+	name := d.Get("name").(string)
+
+	for _, a := range applications {
+		if a.Name == name {
+			application = &a
+			break
+		}
+	}
+
+	if application == nil {
+		return fmt.Errorf("The name '%s' does not match any New Relic mobile applications.", name)
+	}
+
+	d.SetId(strconv.Itoa(application.ID))
+	d.Set("name", application.Name)
+	d.Set("health_status", application.HealthStatus)
+	d.Set("reporting", application.Reporting)
+
+	crashSummary := []map[string]interface{}{
+		{
+			"supports_crash_data":    application.CrashSummary.SupportsCrashData,
+			"unresolved_crash_count": application.CrashSummary.UnresolvedCrashCount,
+			"crash_count":            application.CrashSummary.CrashCount,
+			"crash_rate":             application.CrashSummary.CrashRate,
+		},
+	}
+
+	if err := d.Set("crash_summary", crashSummary); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting mobile application crash summary: %#v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicMobileApplication_Basic(t *testing.T) {
+	name := os.Getenv("NEWRELIC_MOBILE_APPLICATION_NAME")
+	if name == "" {
+		t.Skip("NEWRELIC_MOBILE_APPLICATION_NAME must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicMobileApplicationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.newrelic_mobile_application.app", "id"),
+					resource.TestCheckResourceAttr("data.newrelic_mobile_application.app", "name", name),
+					resource.TestCheckResourceAttr("data.newrelic_mobile_application.app", "crash_summary.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// Mobile applications are created by the mobile agent, so this test
+// requires an existing mobile application in the account.
+func testAccNewRelicMobileApplicationConfig(name string) string {
+	return fmt.Sprintf(`
+data "newrelic_mobile_application" "app" {
+	name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,132 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func summaryMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"metric": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value_function": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenSummaryMetrics(metrics []newrelic.SummaryMetric) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(metrics))
+
+	for i, m := range metrics {
+		result[i] = map[string]interface{}{
+			"id":             m.ID,
+			"name":           m.Name,
+			"metric":         m.Metric,
+			"value_function": m.ValueFunction,
+		}
+	}
+
+	return result
+}
+
+func dataSourceNewRelicPlugin() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicPluginRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_agent_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"summary_metrics": summaryMetricsSchema(),
+		},
+	}
+}
+
+func dataSourceNewRelicPluginRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	guid, hasGUID := d.GetOk("guid")
+	name, hasName := d.GetOk("name")
+
+	if hasGUID == hasName {
+		return fmt.Errorf("Exactly one of 'guid' or 'name' must be set to look up a New Relic plugin.")
+	}
+
+	filters := newrelic.PluginsFilters{}
+	if hasGUID {
+		g := guid.(string)
+		filters.GUID = &g
+	}
+
+	log.Printf("[INFO] Reading New Relic plugins")
+
+	plugins, err := client.QueryPlugins(filters)
+	if err != nil {
+		return err
+	}
+
+	var plugin *newrelic.Plugin
+	for _, p := range plugins {
+		if (hasGUID && p.GUID == guid.(string)) || (hasName && p.Name == name.(string)) {
+			plugin = &p
+			break
+		}
+	}
+
+	if plugin == nil {
+		if hasGUID {
+			return fmt.Errorf("The guid '%s' does not match any New Relic plugins.", guid)
+		}
+
+		return fmt.Errorf("The name '%s' does not match any New Relic plugins.", name)
+	}
+
+	d.SetId(strconv.Itoa(plugin.ID))
+	d.Set("guid", plugin.GUID)
+	d.Set("name", plugin.Name)
+	d.Set("publisher", plugin.Publisher)
+	d.Set("component_agent_count", plugin.ComponentAgentCount)
+
+	if err := d.Set("summary_metrics", flattenSummaryMetrics(plugin.SummaryMetrics)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting plugin summary metrics: %#v", err)
+	}
+
+	return nil
+}
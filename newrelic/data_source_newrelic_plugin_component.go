@@ -0,0 +1,68 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicPluginComponent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicPluginComponentRead,
+
+		Schema: map[string]*schema.Schema{
+			"plugin_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"summary_metrics": summaryMetricsSchema(),
+		},
+	}
+}
+
+func dataSourceNewRelicPluginComponentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	pluginID := d.Get("plugin_id").(int)
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading New Relic components for plugin %d", pluginID)
+
+	components, err := client.ListComponents(pluginID)
+	if err != nil {
+		return err
+	}
+
+	var component *newrelic.Component
+	for _, c := range components {
+		if c.Name == name {
+			component = &c
+			break
+		}
+	}
+
+	if component == nil {
+		return fmt.Errorf("The name '%s' does not match any New Relic components of plugin %d.", name, pluginID)
+	}
+
+	d.SetId(strconv.Itoa(component.ID))
+	d.Set("name", component.Name)
+	d.Set("health_status", component.HealthStatus)
+
+	if err := d.Set("summary_metrics", flattenSummaryMetrics(component.SummaryMetrics)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting component summary metrics: %#v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicPluginComponent_Basic(t *testing.T) {
+	guid := os.Getenv("NEWRELIC_PLUGIN_GUID")
+	name := os.Getenv("NEWRELIC_PLUGIN_COMPONENT_NAME")
+	if guid == "" || name == "" {
+		t.Skip("NEWRELIC_PLUGIN_GUID and NEWRELIC_PLUGIN_COMPONENT_NAME must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicPluginComponentConfig(guid, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.newrelic_plugin_component.foo", "id"),
+					resource.TestCheckResourceAttr("data.newrelic_plugin_component.foo", "name", name),
+					resource.TestCheckResourceAttrSet("data.newrelic_plugin_component.foo", "health_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicPluginComponentConfig(guid string, name string) string {
+	return fmt.Sprintf(`
+data "newrelic_plugin" "foo" {
+	guid = "%s"
+}
+
+data "newrelic_plugin_component" "foo" {
+	plugin_id = "${data.newrelic_plugin.foo.id}"
+	name      = "%s"
+}
+`, guid, name)
+}
@@ -0,0 +1,47 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicPlugin_Basic(t *testing.T) {
+	guid := os.Getenv("NEWRELIC_PLUGIN_GUID")
+	if guid == "" {
+		t.Skip("NEWRELIC_PLUGIN_GUID must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicPluginConfig(guid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.newrelic_plugin.by_guid", "id"),
+					resource.TestCheckResourceAttr("data.newrelic_plugin.by_guid", "guid", guid),
+					resource.TestCheckResourceAttrPair(
+						"data.newrelic_plugin.by_name", "id",
+						"data.newrelic_plugin.by_guid", "id"),
+				),
+			},
+		},
+	})
+}
+
+// Plugins cannot be created through the REST API, so this test requires a
+// plugin with at least one component reporting to the account.
+func testAccNewRelicPluginConfig(guid string) string {
+	return fmt.Sprintf(`
+data "newrelic_plugin" "by_guid" {
+	guid = "%s"
+}
+
+data "newrelic_plugin" "by_name" {
+	name = "${data.newrelic_plugin.by_guid.name}"
+}
+`, guid)
+}
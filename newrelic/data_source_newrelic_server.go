@@ -0,0 +1,95 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicServer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicServerRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reporting": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_reported_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	name, hasName := d.GetOk("name")
+	host, hasHost := d.GetOk("host")
+
+	if hasName == hasHost {
+		return fmt.Errorf("Exactly one of 'name' or 'host' must be set to look up a New Relic server.")
+	}
+
+	filters := newrelic.ServersFilters{}
+
+	if hasName {
+		n := name.(string)
+		filters.Name = &n
+	} else {
+		h := host.(string)
+		filters.Host = &h
+	}
+
+	log.Printf("[INFO] Reading New Relic servers")
+
+	servers, err := client.QueryServers(filters)
+	if err != nil {
+		return err
+	}
+
+	// The API filters are partial matches, so only keep an exact match.
+	var server *newrelic.Server
+	for _, s := range servers {
+		if (hasName && s.Name == name.(string)) || (hasHost && s.Host == host.(string)) {
+			server = &s
+			break
+		}
+	}
+
+	if server == nil {
+		if hasName {
+			return fmt.Errorf("The name '%s' does not match any New Relic servers.", name)
+		}
+
+		return fmt.Errorf("The host '%s' does not match any New Relic servers.", host)
+	}
+
+	d.SetId(strconv.Itoa(server.ID))
+	d.Set("name", server.Name)
+	d.Set("host", server.Host)
+	d.Set("health_status", server.HealthStatus)
+	d.Set("reporting", server.Reporting)
+	d.Set("last_reported_at", server.LastReportedAt)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicServer_Basic(t *testing.T) {
+	host := os.Getenv("NEWRELIC_SERVER_HOST")
+	if host == "" {
+		t.Skip("NEWRELIC_SERVER_HOST must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicServerConfig(host),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.newrelic_server.server", "id"),
+					resource.TestCheckResourceAttr("data.newrelic_server.server", "host", host),
+					resource.TestCheckResourceAttrPair(
+						"data.newrelic_server.by_name", "id",
+						"data.newrelic_server.server", "id"),
+				),
+			},
+		},
+	})
+}
+
+// Servers are created by the legacy servers agent, so this test requires an
+// existing server in the account.
+func testAccNewRelicServerConfig(host string) string {
+	return fmt.Sprintf(`
+data "newrelic_server" "server" {
+	host = "%s"
+}
+
+data "newrelic_server" "by_name" {
+	name = "${data.newrelic_server.server.name}"
+}
+`, host)
+}
@@ -0,0 +1,77 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func dataSourceNewRelicUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNewRelicUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	email := d.Get("email").(string)
+
+	log.Printf("[INFO] Reading New Relic users with email %s", email)
+
+	users, err := client.QueryUsers(newrelic.UsersFilters{
+		Email: &email,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Email addresses are case-insensitive and the filter may be a partial match.
+	var user *newrelic.User
+	for _, u := range users {
+		if strings.EqualFold(u.Email, email) {
+			user = &u
+			break
+		}
+	}
+
+	if user == nil {
+		return fmt.Errorf("The email '%s' does not match any New Relic users.", email)
+	}
+
+	d.SetId(strconv.Itoa(user.ID))
+	d.Set("email", user.Email)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("name", strings.TrimSpace(user.FirstName+" "+user.LastName))
+	d.Set("role", user.Role)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNewRelicUser_Basic(t *testing.T) {
+	email := os.Getenv("NEWRELIC_USER_EMAIL")
+	if email == "" {
+		t.Skip("NEWRELIC_USER_EMAIL must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNewRelicUserConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.newrelic_user.user", "id"),
+					resource.TestCheckResourceAttrSet("data.newrelic_user.user", "role"),
+				),
+			},
+		},
+	})
+}
+
+// Users cannot be created through the REST API, so this test requires an
+// existing user in the account.
+func testAccNewRelicUserConfig(email string) string {
+	return fmt.Sprintf(`
+data "newrelic_user" "user" {
+	email = "%s"
+}
+`, email)
+}
@@ -2,6 +2,7 @@ package newrelic
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -35,3 +36,60 @@ func serializeIDs(ids []int) string {
 
 	return strings.Join(idStrings, ":")
 }
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeInt(values []int, v int) []int {
+	result := make([]int, 0, len(values))
+	for _, value := range values {
+		if value != v {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func containsAnyInt(values []int, candidates []int) bool {
+	for _, v := range candidates {
+		if containsInt(values, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameIntSet reports whether a and b contain the same values, ignoring order
+// and duplicates.
+func sameIntSet(a []int, b []int) bool {
+	for _, v := range a {
+		if !containsInt(b, v) {
+			return false
+		}
+	}
+
+	for _, v := range b {
+		if !containsInt(a, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortedInts returns a sorted copy of the values.
+func sortedInts(values []int) []int {
+	sorted := append([]int{}, values...)
+	sort.Ints(sorted)
+
+	return sorted
+}
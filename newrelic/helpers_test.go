@@ -1,6 +1,9 @@
 package newrelic
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestParseIDs_Basic(t *testing.T) {
 	ids, err := parseIDs("1:2", 2)
@@ -24,3 +27,29 @@ func TestSerializeIDs_Basic(t *testing.T) {
 		t.Fatal(id)
 	}
 }
+
+func TestSameIntSet_Basic(t *testing.T) {
+	if !sameIntSet([]int{1, 2}, []int{2, 1}) {
+		t.Fatal("expected sets with the same values in a different order to match")
+	}
+
+	if sameIntSet([]int{1, 2}, []int{1}) {
+		t.Fatal("expected sets with different values not to match")
+	}
+
+	if !sameIntSet(nil, []int{}) {
+		t.Fatal("expected empty sets to match")
+	}
+}
+
+func TestSortedInts_Basic(t *testing.T) {
+	values := []int{3, 1, 2}
+
+	if sorted := sortedInts(values); !reflect.DeepEqual(sorted, []int{1, 2, 3}) {
+		t.Fatal(sorted)
+	}
+
+	if !reflect.DeepEqual(values, []int{3, 1, 2}) {
+		t.Fatal("expected the values to be left unsorted")
+	}
+}
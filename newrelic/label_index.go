@@ -0,0 +1,73 @@
+package newrelic
+
+import (
+	"log"
+	"sync"
+
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+// labelIndex caches the labels of an account. The API can only return a
+// label by paging through all of them, so alert conditions that resolve their
+// entities from a label share one listing per run.
+type labelIndex struct {
+	mu     sync.Mutex
+	labels map[string]newrelic.Label
+}
+
+var (
+	labelIndexesLock sync.Mutex
+	labelIndexes     = map[*newrelic.Client]*labelIndex{}
+)
+
+// labelIndexFor returns the shared label index for the client.
+func labelIndexFor(client *newrelic.Client) *labelIndex {
+	labelIndexesLock.Lock()
+	defer labelIndexesLock.Unlock()
+
+	index, ok := labelIndexes[client]
+	if !ok {
+		index = &labelIndex{}
+		labelIndexes[client] = index
+	}
+
+	return index
+}
+
+// Get returns the label with the given key, or newrelic.ErrNotFound.
+func (i *labelIndex) Get(client *newrelic.Client, key string) (*newrelic.Label, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.labels == nil {
+		log.Printf("[INFO] Listing New Relic labels")
+
+		labels, err := client.ListLabels()
+		if err != nil {
+			return nil, err
+		}
+
+		i.labels = make(map[string]newrelic.Label, len(labels))
+		for _, label := range labels {
+			i.labels[label.Key] = label
+		}
+	}
+
+	label, ok := i.labels[key]
+	if !ok {
+		return nil, newrelic.ErrNotFound
+	}
+
+	label.Links.Applications = append([]int{}, label.Links.Applications...)
+	label.Links.Servers = append([]int{}, label.Links.Servers...)
+
+	return &label, nil
+}
+
+// Invalidate drops the cached labels so the next lookup lists them again.
+func (i *labelIndex) Invalidate() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.labels = nil
+}
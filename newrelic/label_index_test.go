@@ -0,0 +1,41 @@
+package newrelic
+
+import (
+	"reflect"
+	"testing"
+
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func TestLabelIndex_Get(t *testing.T) {
+	index := &labelIndex{
+		labels: map[string]newrelic.Label{
+			"Team:Payments": {Key: "Team:Payments", Links: newrelic.LabelLinks{Applications: []int{1, 2}}},
+		},
+	}
+
+	label, err := index.Get(nil, "Team:Payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(label.Links.Applications, []int{1, 2}) {
+		t.Fatal(label.Links.Applications)
+	}
+
+	// The returned label must not share its links with the index.
+	label.Links.Applications[0] = 3
+
+	label, err = index.Get(nil, "Team:Payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if label.Links.Applications[0] != 1 {
+		t.Fatal("expected the index to be unchanged")
+	}
+
+	if _, err := index.Get(nil, "Team:Other"); err != newrelic.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
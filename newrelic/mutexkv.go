@@ -0,0 +1,63 @@
+package newrelic
+
+import (
+	"log"
+	"strconv"
+	"sync"
+)
+
+// mutexKV is a simple key/value store of mutexes, used to serialize
+// operations that share a key while letting other keys run in parallel.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// Lock acquires the mutex for the given key, blocking until it is available.
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock releases the mutex for the given key.
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+
+	return mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// alertPolicyMutexKV serializes changes to the same alert policy across the
+// policy, condition and policy channel resources.
+var alertPolicyMutexKV = newMutexKV()
+
+func lockAlertPolicy(policyID int) {
+	alertPolicyMutexKV.Lock(alertPolicyLockKey(policyID))
+}
+
+func unlockAlertPolicy(policyID int) {
+	alertPolicyMutexKV.Unlock(alertPolicyLockKey(policyID))
+}
+
+func alertPolicyLockKey(policyID int) string {
+	return "newrelic_alert_policy/" + strconv.Itoa(policyID)
+}
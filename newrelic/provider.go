@@ -22,17 +22,41 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NEWRELIC_API_URL", "https://api.newrelic.com/v2"),
 			},
+			"synthetics_api_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NEWRELIC_SYNTHETICS_API_URL", "https://synthetics.newrelic.com/synthetics/api"),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"newrelic_application": dataSourceNewRelicApplication(),
+			"newrelic_alert_policy":        dataSourceNewRelicAlertPolicy(),
+			"newrelic_alert_violations":    dataSourceNewRelicAlertViolations(),
+			"newrelic_application":         dataSourceNewRelicApplication(),
+			"newrelic_application_hosts":   dataSourceNewRelicApplicationHosts(),
+			"newrelic_applications":        dataSourceNewRelicApplications(),
+			"newrelic_browser_application": dataSourceNewRelicBrowserApplication(),
+			"newrelic_key_transaction":     dataSourceNewRelicKeyTransaction(),
+			"newrelic_label":               dataSourceNewRelicLabel(),
+			"newrelic_metric_data":         dataSourceNewRelicMetricData(),
+			"newrelic_mobile_application":  dataSourceNewRelicMobileApplication(),
+			"newrelic_plugin":              dataSourceNewRelicPlugin(),
+			"newrelic_plugin_component":    dataSourceNewRelicPluginComponent(),
+			"newrelic_server":              dataSourceNewRelicServer(),
+			"newrelic_user":                dataSourceNewRelicUser(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"newrelic_alert_channel":        resourceNewRelicAlertChannel(),
-			"newrelic_alert_condition":      resourceNewRelicAlertCondition(),
-			"newrelic_alert_policy":         resourceNewRelicAlertPolicy(),
-			"newrelic_alert_policy_channel": resourceNewRelicAlertPolicyChannel(),
+			"newrelic_alert_channel":                resourceNewRelicAlertChannel(),
+			"newrelic_alert_condition":              resourceNewRelicAlertCondition(),
+			"newrelic_alert_policy":                 resourceNewRelicAlertPolicy(),
+			"newrelic_alert_policy_channel":         resourceNewRelicAlertPolicyChannel(),
+			"newrelic_alert_policy_channels":        resourceNewRelicAlertPolicyChannels(),
+			"newrelic_application_settings":         resourceNewRelicApplicationSettings(),
+			"newrelic_label":                        resourceNewRelicLabel(),
+			"newrelic_synthetics_monitor":           resourceNewRelicSyntheticsMonitor(),
+			"newrelic_synthetics_monitor_script":    resourceNewRelicSyntheticsMonitorScript(),
+			"newrelic_synthetics_secure_credential": resourceNewRelicSyntheticsSecureCredential(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -41,8 +65,9 @@ func Provider() terraform.ResourceProvider {
 
 func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		APIKey: data.Get("api_key").(string),
-		APIURL: data.Get("api_url").(string),
+		APIKey:           data.Get("api_key").(string),
+		APIURL:           data.Get("api_url").(string),
+		SyntheticsAPIURL: data.Get("synthetics_api_url").(string),
 	}
 	log.Println("[INFO] Initializing New Relic client")
 	return config.Client()
@@ -104,7 +104,7 @@ func buildAlertChannelStruct(d *schema.ResourceData) *newrelic.AlertChannel {
 }
 
 func resourceNewRelicAlertChannelCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 	channel := buildAlertChannelStruct(d)
 
 	log.Printf("[INFO] Creating New Relic alert channel %s", channel.Name)
@@ -114,13 +114,15 @@ func resourceNewRelicAlertChannelCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	alertChannelIndexFor(client).Put(*channel)
+
 	d.SetId(strconv.Itoa(channel.ID))
 
 	return nil
 }
 
 func resourceNewRelicAlertChannelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 32)
 	if err != nil {
@@ -129,7 +131,7 @@ func resourceNewRelicAlertChannelRead(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Reading New Relic alert channel %v", id)
 
-	channel, err := client.GetAlertChannel(int(id))
+	channel, err := alertChannelIndexFor(client).Get(client, int(id))
 	if err != nil {
 		if err == newrelic.ErrNotFound {
 			d.SetId("")
@@ -149,7 +151,7 @@ func resourceNewRelicAlertChannelRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNewRelicAlertChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 32)
 	if err != nil {
@@ -162,6 +164,8 @@ func resourceNewRelicAlertChannelDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	alertChannelIndexFor(client).Remove(int(id))
+
 	d.SetId("")
 
 	return nil
@@ -97,10 +97,24 @@ func resourceNewRelicAlertCondition() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(validAlertConditionTypes, false),
 			},
 			"entities": {
-				Type:     schema.TypeList,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
-				Optional: true, // change this to optional for NRQL
-				MinItems: 1,
+				Type:          schema.TypeList,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				Optional:      true, // change this to optional for NRQL
+				Computed:      true, // resolved from entity_label when it is set
+				MinItems:      1,
+				ConflictsWith: []string{"entity_label"},
+			},
+			"entity_label": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"entities"},
+			},
+			// Set by Read when the label's entities differ from the condition's,
+			// so the plan shows a change. It must never be configured.
+			"entity_label_drift": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAlertConditionEntityLabelDrift,
 			},
 			"metric": {
 				Type:     schema.TypeString,
@@ -261,17 +275,19 @@ func buildAlertConditionStruct(d *schema.ResourceData) *newrelic.AlertCondition
 			fmt.Printf("Must set matric value for metric-type conditions")
 			os.Exit(1)
 		}
-		if attrE, ok := d.GetOk("entities"); ok {
-			entitySet := attrE.([]interface{})
-			entities := make([]string, len(entitySet))
-
-			for i, entity := range entitySet {
-				entities[i] = strconv.Itoa(entity.(int))
+		if _, ok := d.GetOk("entity_label"); !ok { // entities are resolved from the label at apply time
+			if attrE, ok := d.GetOk("entities"); ok {
+				entitySet := attrE.([]interface{})
+				entities := make([]string, len(entitySet))
+
+				for i, entity := range entitySet {
+					entities[i] = strconv.Itoa(entity.(int))
+				}
+				condition.Entities = entities
+			} else { // check for entities
+				fmt.Printf("Must set entities for metric-type conditions")
+				os.Exit(1)
 			}
-			condition.Entities = entities
-		} else { // check for entities
-			fmt.Printf("Must set entities for metric-type conditions")
-			os.Exit(1)
 		}
 		if _, ok := d.GetOk("type"); ok { // check for type
 			condition.Type = d.Get("type").(string)
@@ -357,12 +373,71 @@ func readAlertConditionStruct(condition *newrelic.AlertCondition, d *schema.Reso
 	return nil
 }
 
+// resolveAlertConditionEntityLabel returns the IDs of the entities linked to
+// the label: servers for servers_metric conditions and applications for
+// apm_app_metric conditions. Labels cannot link other kinds of entities.
+func resolveAlertConditionEntityLabel(client *newrelic.Client, key string, conditionType string) ([]int, error) {
+	if conditionType != "apm_app_metric" && conditionType != "servers_metric" {
+		return nil, fmt.Errorf("entity_label is only supported for apm_app_metric and servers_metric conditions, not %s.", conditionType)
+	}
+
+	label, err := labelIndexFor(client).Get(client, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if conditionType == "servers_metric" {
+		return label.Links.Servers, nil
+	}
+
+	return label.Links.Applications, nil
+}
+
+func setAlertConditionEntitiesFromLabel(client *newrelic.Client, condition *newrelic.AlertCondition, d *schema.ResourceData) error {
+	attr, ok := d.GetOk("entity_label")
+	if !ok {
+		return nil
+	}
+
+	key := attr.(string)
+
+	log.Printf("[INFO] Resolving entities of New Relic label %s", key)
+
+	ids, err := resolveAlertConditionEntityLabel(client, key, condition.Type)
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			return fmt.Errorf("The label '%s' does not match any New Relic labels.", key)
+		}
+
+		return err
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("The label '%s' is not linked to any entities for condition type %s.", key, condition.Type)
+	}
+
+	entities := make([]string, len(ids))
+	for i, id := range ids {
+		entities[i] = strconv.Itoa(id)
+	}
+	condition.Entities = entities
+
+	return nil
+}
+
 func resourceNewRelicAlertConditionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 	condition := buildAlertConditionStruct(d)
 
+	if err := setAlertConditionEntitiesFromLabel(client, condition, d); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Creating New Relic alert condition %s", condition.Name)
 
+	lockAlertPolicy(condition.PolicyID)
+	defer unlockAlertPolicy(condition.PolicyID)
+
 	condition, err := client.CreateAlertCondition(*condition)
 	if err != nil {
 		return err
@@ -374,7 +449,7 @@ func resourceNewRelicAlertConditionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceNewRelicAlertConditionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	log.Printf("[INFO] Reading New Relic alert condition %s", d.Id())
 
@@ -396,13 +471,67 @@ func resourceNewRelicAlertConditionRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	return readAlertConditionStruct(condition, d)
+	if err := readAlertConditionStruct(condition, d); err != nil {
+		return err
+	}
+
+	return readAlertConditionEntityLabelDrift(client, condition, d)
+}
+
+// readAlertConditionEntityLabelDrift compares the entities the label resolves
+// to with the refreshed entities. The helper/schema in use has no way to
+// customize a diff, and entities is Computed when a label is used, so a
+// mismatch is recorded in entity_label_drift instead; its config value is
+// always empty, so the plan shows the drift being cleared and the update
+// applies the label's entities again.
+func readAlertConditionEntityLabelDrift(client *newrelic.Client, condition *newrelic.AlertCondition, d *schema.ResourceData) error {
+	drift := ""
+
+	if attr, ok := d.GetOk("entity_label"); ok {
+		key := attr.(string)
+
+		ids, err := resolveAlertConditionEntityLabel(client, key, condition.Type)
+		if err != nil && err != newrelic.ErrNotFound {
+			return err
+		}
+
+		entities := make([]int, len(condition.Entities))
+		for i, entity := range condition.Entities {
+			entities[i], _ = strconv.Atoi(entity)
+		}
+
+		if err == newrelic.ErrNotFound {
+			drift = fmt.Sprintf("label %s not found, condition has %v", key, sortedInts(entities))
+		} else if !sameIntSet(ids, entities) {
+			drift = fmt.Sprintf("label %s has %v, condition has %v", key, sortedInts(ids), sortedInts(entities))
+		}
+
+		if drift != "" {
+			log.Printf("[INFO] Entities of alert condition %s drifted: %s", d.Id(), drift)
+		}
+	}
+
+	d.Set("entity_label_drift", drift)
+
+	return nil
+}
+
+func validateAlertConditionEntityLabelDrift(i interface{}, k string) (s []string, es []error) {
+	if v, ok := i.(string); ok && v != "" {
+		es = append(es, fmt.Errorf("%s is set by the provider and must not be configured", k))
+	}
+
+	return
 }
 
 func resourceNewRelicAlertConditionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 	condition := buildAlertConditionStruct(d)
 
+	if err := setAlertConditionEntitiesFromLabel(client, condition, d); err != nil {
+		return err
+	}
+
 	ids, err := parseIDs(d.Id(), 2)
 	if err != nil {
 		return err
@@ -416,6 +545,9 @@ func resourceNewRelicAlertConditionUpdate(d *schema.ResourceData, meta interface
 
 	log.Printf("[INFO] Updating New Relic alert condition %d", id)
 
+	lockAlertPolicy(policyID)
+	defer unlockAlertPolicy(policyID)
+
 	updatedCondition, err := client.UpdateAlertCondition(*condition)
 	if err != nil {
 		return err
@@ -425,7 +557,7 @@ func resourceNewRelicAlertConditionUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceNewRelicAlertConditionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	ids, err := parseIDs(d.Id(), 2)
 	if err != nil {
@@ -437,6 +569,9 @@ func resourceNewRelicAlertConditionDelete(d *schema.ResourceData, meta interface
 
 	log.Printf("[INFO] Deleting New Relic alert condition %d", id)
 
+	lockAlertPolicy(policyID)
+	defer unlockAlertPolicy(policyID)
+
 	if err := client.DeleteAlertCondition(policyID, id); err != nil {
 		return err
 	}
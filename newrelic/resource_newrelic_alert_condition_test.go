@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	newrelic "github.com/paultyng/go-newrelic/api"
 )
 
 func TestAccNewRelicAlertCondition_Basic(t *testing.T) {
@@ -164,10 +163,36 @@ func TestAccNewRelicAlertCondition_ZeroThreshold(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicAlertCondition_EntityLabel(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicAlertConditionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNewRelicAlertConditionConfigEntityLabel(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicAlertConditionExists("newrelic_alert_condition.foo"),
+					resource.TestCheckResourceAttr(
+						"newrelic_alert_condition.foo", "entity_label", fmt.Sprintf("Team:tf-test-%s", rName)),
+					resource.TestCheckResourceAttr(
+						"newrelic_alert_condition.foo", "entities.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"newrelic_alert_condition.foo", "entities.0",
+						"data.newrelic_application.app", "id"),
+					resource.TestCheckResourceAttr(
+						"newrelic_alert_condition.foo", "entity_label_drift", ""),
+				),
+			},
+		},
+	})
+}
+
 // TODO: func TestAccNewRelicAlertCondition_Multi(t *testing.T) {
 
 func testAccCheckNewRelicAlertConditionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*newrelic.Client)
+	client := testAccProvider.Meta().(*ProviderConfig).Client
 	for _, r := range s.RootModule().Resources {
 		if r.Type != "newrelic_alert_condition" {
 			continue
@@ -200,7 +225,7 @@ func testAccCheckNewRelicAlertConditionExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No alert condition ID is set")
 		}
 
-		client := testAccProvider.Meta().(*newrelic.Client)
+		client := testAccProvider.Meta().(*ProviderConfig).Client
 
 		ids, err := parseIDs(rs.Primary.ID, 2)
 		if err != nil {
@@ -316,6 +341,41 @@ resource "newrelic_alert_condition" "foo" {
 `, rName, testAccExpectedApplicationName)
 }
 
+func testAccCheckNewRelicAlertConditionConfigEntityLabel(rName string) string {
+	return fmt.Sprintf(`
+data "newrelic_application" "app" {
+  name = "%[2]s"
+}
+
+resource "newrelic_label" "foo" {
+  category        = "Team"
+  name            = "tf-test-%[1]s"
+  application_ids = ["${data.newrelic_application.app.id}"]
+}
+
+resource "newrelic_alert_policy" "foo" {
+  name = "tf-test-%[1]s"
+}
+
+resource "newrelic_alert_condition" "foo" {
+  policy_id = "${newrelic_alert_policy.foo.id}"
+
+  name         = "tf-test-%[1]s"
+  type         = "apm_app_metric"
+  entity_label = "${newrelic_label.foo.id}"
+  metric       = "apdex"
+
+  term {
+    duration      = 5
+    operator      = "below"
+    priority      = "critical"
+    threshold     = "0.75"
+    time_function = "all"
+  }
+}
+`, rName, testAccExpectedApplicationName)
+}
+
 // TODO: const testAccCheckNewRelicAlertConditionConfigMulti = `
 
 // add tests for NRQL alert conditions
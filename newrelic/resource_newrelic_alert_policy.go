@@ -13,7 +13,7 @@ func resourceNewRelicAlertPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNewRelicAlertPolicyCreate,
 		Read:   resourceNewRelicAlertPolicyRead,
-		// Update: Not currently supported in API
+		Update: resourceNewRelicAlertPolicyUpdate,
 		Delete: resourceNewRelicAlertPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -22,13 +22,11 @@ func resourceNewRelicAlertPolicy() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"incident_preference": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "PER_POLICY",
-				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"PER_POLICY", "PER_CONDITION", "PER_CONDITION_AND_TARGET"}, false),
 			},
 			"created_at": {
@@ -56,7 +54,7 @@ func buildAlertPolicyStruct(d *schema.ResourceData) *newrelic.AlertPolicy {
 }
 
 func resourceNewRelicAlertPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 	policy := buildAlertPolicyStruct(d)
 
 	log.Printf("[INFO] Creating New Relic alert policy %s", policy.Name)
@@ -72,7 +70,7 @@ func resourceNewRelicAlertPolicyCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNewRelicAlertPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 32)
 	if err != nil {
@@ -99,8 +97,32 @@ func resourceNewRelicAlertPolicyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceNewRelicAlertPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+	policy := buildAlertPolicyStruct(d)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	policy.ID = int(id)
+
+	log.Printf("[INFO] Updating New Relic alert policy %d", id)
+
+	lockAlertPolicy(policy.ID)
+	defer unlockAlertPolicy(policy.ID)
+
+	_, err = client.UpdateAlertPolicy(*policy)
+	if err != nil {
+		return err
+	}
+
+	return resourceNewRelicAlertPolicyRead(d, meta)
+}
+
 func resourceNewRelicAlertPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 32)
 	if err != nil {
@@ -109,10 +131,15 @@ func resourceNewRelicAlertPolicyDelete(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Deleting New Relic alert policy %v", id)
 
+	lockAlertPolicy(int(id))
+	defer unlockAlertPolicy(int(id))
+
 	if err := client.DeleteAlertPolicy(int(id)); err != nil {
 		return err
 	}
 
+	alertChannelIndexFor(client).RemovePolicy(int(id))
+
 	d.SetId("")
 
 	return nil
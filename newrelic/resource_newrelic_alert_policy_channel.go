@@ -1,6 +1,7 @@
 package newrelic
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -8,7 +9,7 @@ import (
 )
 
 func policyChannelExists(client *newrelic.Client, policyID int, channelID int) (bool, error) {
-	channel, err := client.GetAlertChannel(channelID)
+	channel, err := alertChannelIndexFor(client).Get(client, channelID)
 	if err != nil {
 		if err == newrelic.ErrNotFound {
 			return false, nil
@@ -17,13 +18,7 @@ func policyChannelExists(client *newrelic.Client, policyID int, channelID int) (
 		return false, err
 	}
 
-	for _, id := range channel.Links.PolicyIDs {
-		if id == policyID {
-			return true, nil
-		}
-	}
-
-	return false, nil
+	return containsInt(channel.Links.PolicyIDs, policyID), nil
 }
 
 func resourceNewRelicAlertPolicyChannel() *schema.Resource {
@@ -32,6 +27,9 @@ func resourceNewRelicAlertPolicyChannel() *schema.Resource {
 		Read:   resourceNewRelicAlertPolicyChannelRead,
 		// Update: Not currently supported in API
 		Delete: resourceNewRelicAlertPolicyChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNewRelicAlertPolicyChannelImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Type:     schema.TypeInt,
@@ -47,14 +45,45 @@ func resourceNewRelicAlertPolicyChannel() *schema.Resource {
 	}
 }
 
+func resourceNewRelicAlertPolicyChannelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).Client
+
+	ids, err := parseIDs(d.Id(), 2)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid alert policy channel ID %q, expected <policy_id>:<channel_id>", d.Id())
+	}
+
+	policyID := ids[0]
+	channelID := ids[1]
+
+	log.Printf("[INFO] Importing New Relic alert policy channel %s", d.Id())
+
+	exists, err := policyChannelExists(client, policyID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("Channel %d is not linked to alert policy %d", channelID, policyID)
+	}
+
+	d.Set("policy_id", policyID)
+	d.Set("channel_id", channelID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNewRelicAlertPolicyChannelCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	policyID := d.Get("policy_id").(int)
 	channelID := d.Get("channel_id").(int)
 
 	serializedID := serializeIDs([]int{policyID, channelID})
 
+	lockAlertPolicy(policyID)
+	defer unlockAlertPolicy(policyID)
+
 	log.Printf("[INFO] Creating New Relic alert policy channel %s", serializedID)
 
 	exists, err := policyChannelExists(client, policyID, channelID)
@@ -63,7 +92,7 @@ func resourceNewRelicAlertPolicyChannelCreate(d *schema.ResourceData, meta inter
 	}
 
 	if !exists {
-		err = client.UpdateAlertPolicyChannels(policyID, []int{channelID})
+		err = linkAlertPolicyChannels(client, policyID, []int{channelID})
 		if err != nil {
 			return err
 		}
@@ -75,7 +104,7 @@ func resourceNewRelicAlertPolicyChannelCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceNewRelicAlertPolicyChannelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	ids, err := parseIDs(d.Id(), 2)
 	if err != nil {
@@ -104,7 +133,7 @@ func resourceNewRelicAlertPolicyChannelRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceNewRelicAlertPolicyChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*newrelic.Client)
+	client := meta.(*ProviderConfig).Client
 
 	ids, err := parseIDs(d.Id(), 2)
 	if err != nil {
@@ -116,13 +145,16 @@ func resourceNewRelicAlertPolicyChannelDelete(d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Deleting New Relic alert policy channel %s", d.Id())
 
+	lockAlertPolicy(policyID)
+	defer unlockAlertPolicy(policyID)
+
 	exists, err := policyChannelExists(client, policyID, channelID)
 	if err != nil {
 		return err
 	}
 
 	if exists {
-		if err := client.DeleteAlertPolicyChannel(policyID, channelID); err != nil {
+		if err := unlinkAlertPolicyChannel(client, policyID, channelID); err != nil {
 			switch err {
 			case newrelic.ErrNotFound:
 				return nil
@@ -0,0 +1,190 @@
+package newrelic
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func policyChannelIDs(client *newrelic.Client, policyID int) ([]int, error) {
+	return alertChannelIndexFor(client).PolicyChannelIDs(client, policyID)
+}
+
+func resourceNewRelicAlertPolicyChannels() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNewRelicAlertPolicyChannelsCreate,
+		Read:   resourceNewRelicAlertPolicyChannelsRead,
+		Update: resourceNewRelicAlertPolicyChannelsUpdate,
+		Delete: resourceNewRelicAlertPolicyChannelsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"channel_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Required: true,
+				MinItems: 1,
+			},
+		},
+	}
+}
+
+func expandAlertPolicyChannelIDs(d *schema.ResourceData) []int {
+	channelSet := d.Get("channel_ids").(*schema.Set).List()
+	channelIDs := make([]int, len(channelSet))
+
+	for i, channelID := range channelSet {
+		channelIDs[i] = channelID.(int)
+	}
+
+	return channelIDs
+}
+
+// syncAlertPolicyChannels links the desired channels to the policy and unlinks any
+// other channel currently attached to it.
+func syncAlertPolicyChannels(client *newrelic.Client, policyID int, desired []int) error {
+	current, err := policyChannelIDs(client, policyID)
+	if err != nil {
+		return err
+	}
+
+	currentSet := make(map[int]bool, len(current))
+	for _, channelID := range current {
+		currentSet[channelID] = true
+	}
+
+	desiredSet := make(map[int]bool, len(desired))
+	missing := []int{}
+	for _, channelID := range desired {
+		desiredSet[channelID] = true
+		if !currentSet[channelID] {
+			missing = append(missing, channelID)
+		}
+	}
+
+	if len(missing) > 0 {
+		log.Printf("[INFO] Adding channels %v to New Relic alert policy %d", missing, policyID)
+
+		if err := linkAlertPolicyChannels(client, policyID, missing); err != nil {
+			return err
+		}
+	}
+
+	for _, channelID := range current {
+		if desiredSet[channelID] {
+			continue
+		}
+
+		log.Printf("[INFO] Removing channel %d from New Relic alert policy %d", channelID, policyID)
+
+		if err := unlinkAlertPolicyChannel(client, policyID, channelID); err != nil {
+			if err == newrelic.ErrNotFound {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNewRelicAlertPolicyChannelsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	policyID := d.Get("policy_id").(int)
+	channelIDs := expandAlertPolicyChannelIDs(d)
+
+	log.Printf("[INFO] Creating New Relic alert policy channels for policy %d", policyID)
+
+	lockAlertPolicy(policyID)
+	defer unlockAlertPolicy(policyID)
+
+	if err := syncAlertPolicyChannels(client, policyID, channelIDs); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(policyID))
+
+	return resourceNewRelicAlertPolicyChannelsRead(d, meta)
+}
+
+func resourceNewRelicAlertPolicyChannelsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	policyID, err := strconv.ParseInt(d.Id(), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading New Relic alert policy channels for policy %d", policyID)
+
+	_, err = client.GetAlertPolicy(int(policyID))
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	channelIDs, err := policyChannelIDs(client, int(policyID))
+	if err != nil {
+		return err
+	}
+
+	d.Set("policy_id", int(policyID))
+	d.Set("channel_ids", channelIDs)
+
+	return nil
+}
+
+func resourceNewRelicAlertPolicyChannelsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	policyID := d.Get("policy_id").(int)
+	channelIDs := expandAlertPolicyChannelIDs(d)
+
+	log.Printf("[INFO] Updating New Relic alert policy channels for policy %d", policyID)
+
+	lockAlertPolicy(policyID)
+	defer unlockAlertPolicy(policyID)
+
+	if err := syncAlertPolicyChannels(client, policyID, channelIDs); err != nil {
+		return err
+	}
+
+	return resourceNewRelicAlertPolicyChannelsRead(d, meta)
+}
+
+func resourceNewRelicAlertPolicyChannelsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	policyID := d.Get("policy_id").(int)
+
+	log.Printf("[INFO] Deleting New Relic alert policy channels for policy %d", policyID)
+
+	lockAlertPolicy(policyID)
+	defer unlockAlertPolicy(policyID)
+
+	for _, channelID := range expandAlertPolicyChannelIDs(d) {
+		if err := unlinkAlertPolicyChannel(client, policyID, channelID); err != nil {
+			if err == newrelic.ErrNotFound {
+				continue
+			}
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package newrelic
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNewRelicAlertPolicyChannels_Basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicAlertPolicyChannelsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckNewRelicAlertPolicyChannelsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicAlertPolicyChannelsExists("newrelic_alert_policy_channels.foo", 2),
+					resource.TestCheckResourceAttr(
+						"newrelic_alert_policy_channels.foo", "channel_ids.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckNewRelicAlertPolicyChannelsConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicAlertPolicyChannelsExists("newrelic_alert_policy_channels.foo", 1),
+					resource.TestCheckResourceAttr(
+						"newrelic_alert_policy_channels.foo", "channel_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "newrelic_alert_policy_channels.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicAlertPolicyChannelsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).Client
+	alertChannelIndexFor(client).Invalidate()
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_alert_policy_channels" {
+			continue
+		}
+
+		policyID, err := strconv.ParseInt(r.Primary.ID, 10, 32)
+		if err != nil {
+			return err
+		}
+
+		channelIDs, err := policyChannelIDs(client, int(policyID))
+		if err != nil {
+			return err
+		}
+
+		if len(channelIDs) > 0 {
+			return fmt.Errorf("Policy %d still has channels %v", policyID, channelIDs)
+		}
+	}
+	return nil
+}
+
+func testAccCheckNewRelicAlertPolicyChannelsExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No resource ID is set")
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).Client
+		alertChannelIndexFor(client).Invalidate()
+
+		policyID, err := strconv.ParseInt(rs.Primary.ID, 10, 32)
+		if err != nil {
+			return err
+		}
+
+		channelIDs, err := policyChannelIDs(client, int(policyID))
+		if err != nil {
+			return err
+		}
+
+		if len(channelIDs) != count {
+			return fmt.Errorf("Expected %d channels on policy %d, got %v", count, policyID, channelIDs)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNewRelicAlertPolicyChannelsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+  name = "tf-test-%[1]s"
+}
+
+resource "newrelic_alert_channel" "foo" {
+  name = "tf-test-foo-%[1]s"
+  type = "email"
+
+  configuration = {
+    recipients              = "foo@example.com"
+    include_json_attachment = "1"
+  }
+}
+
+resource "newrelic_alert_channel" "bar" {
+  name = "tf-test-bar-%[1]s"
+  type = "email"
+
+  configuration = {
+    recipients              = "bar@example.com"
+    include_json_attachment = "0"
+  }
+}
+
+resource "newrelic_alert_policy_channels" "foo" {
+  policy_id   = "${newrelic_alert_policy.foo.id}"
+  channel_ids = ["${newrelic_alert_channel.foo.id}", "${newrelic_alert_channel.bar.id}"]
+}
+`, rName)
+}
+
+func testAccCheckNewRelicAlertPolicyChannelsConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+  name = "tf-test-%[1]s"
+}
+
+resource "newrelic_alert_channel" "foo" {
+  name = "tf-test-foo-%[1]s"
+  type = "email"
+
+  configuration = {
+    recipients              = "foo@example.com"
+    include_json_attachment = "1"
+  }
+}
+
+resource "newrelic_alert_channel" "bar" {
+  name = "tf-test-bar-%[1]s"
+  type = "email"
+
+  configuration = {
+    recipients              = "bar@example.com"
+    include_json_attachment = "0"
+  }
+}
+
+resource "newrelic_alert_policy_channels" "foo" {
+  policy_id   = "${newrelic_alert_policy.foo.id}"
+  channel_ids = ["${newrelic_alert_channel.bar.id}"]
+}
+`, rName)
+}
@@ -0,0 +1,254 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func resourceNewRelicApplicationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNewRelicApplicationSettingsCreate,
+		Read:   resourceNewRelicApplicationSettingsRead,
+		Update: resourceNewRelicApplicationSettingsUpdate,
+		Delete: resourceNewRelicApplicationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"app_apdex_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: float64Gte(0.0),
+			},
+			"end_user_apdex_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: float64Gte(0.0),
+			},
+			"enable_real_user_monitoring": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"use_server_side_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// findApplicationForSettings returns the application to adopt, by ID if set
+// and by exact name otherwise.
+func findApplicationForSettings(client *newrelic.Client, d *schema.ResourceData) (*newrelic.Application, error) {
+	if attr, ok := d.GetOk("application_id"); ok {
+		id := attr.(int)
+
+		application, err := client.GetApplication(id)
+		if err != nil {
+			if err == newrelic.ErrNotFound {
+				return nil, fmt.Errorf("The id '%d' does not match any New Relic applications.", id)
+			}
+
+			return nil, err
+		}
+
+		return application, nil
+	}
+
+	attr, ok := d.GetOk("name")
+	if !ok {
+		return nil, fmt.Errorf("One of 'application_id' or 'name' must be set to manage New Relic application settings.")
+	}
+
+	name := attr.(string)
+
+	applications, err := client.QueryApplications(newrelic.ApplicationsFilters{
+		Name: &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []newrelic.Application
+	for _, a := range applications {
+		if a.Name == name {
+			matches = append(matches, a)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("The name '%s' does not match any New Relic applications.", name)
+	}
+
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("The name '%s' matches %d New Relic applications, use 'application_id' instead.", name, len(matches))
+	}
+
+	return &matches[0], nil
+}
+
+// buildApplicationSettingsStruct merges the configured settings over the
+// current ones, so unset optional values are left unchanged.
+func buildApplicationSettingsStruct(current *newrelic.Application, d *schema.ResourceData) *newrelic.Application {
+	application := newrelic.Application{
+		ID:       current.ID,
+		Name:     current.Name,
+		Settings: current.Settings,
+	}
+
+	if attr, ok := d.GetOk("name"); ok {
+		application.Name = attr.(string)
+	}
+
+	if attr, ok := d.GetOk("app_apdex_threshold"); ok {
+		application.Settings.AppApdexThreshold = attr.(float64)
+	}
+
+	if attr, ok := d.GetOk("end_user_apdex_threshold"); ok {
+		application.Settings.EndUserApdexThreshold = attr.(float64)
+	}
+
+	if applicationSettingConfigured(d, "enable_real_user_monitoring") {
+		application.Settings.EnableRealUserMonitoring = d.Get("enable_real_user_monitoring").(bool)
+	}
+
+	if applicationSettingConfigured(d, "use_server_side_config") {
+		application.Settings.UseServerSideConfig = d.Get("use_server_side_config").(bool)
+	}
+
+	return &application
+}
+
+// applicationSettingConfigured reports whether a boolean setting is set in the
+// config, which GetOk cannot tell apart from false. On create an unset
+// setting has no planned value and is missing from the state; afterwards the
+// state tracks the application, so only a change means it is set.
+func applicationSettingConfigured(d *schema.ResourceData, key string) bool {
+	if !d.IsNewResource() {
+		return d.HasChange(key)
+	}
+
+	state := d.State()
+	if state == nil {
+		return false
+	}
+
+	_, ok := state.Attributes[key]
+
+	return ok
+}
+
+func updateApplicationSettings(client *newrelic.Client, current *newrelic.Application, d *schema.ResourceData) error {
+	application := buildApplicationSettingsStruct(current, d)
+
+	log.Printf("[INFO] Updating New Relic application settings %d", application.ID)
+
+	if _, err := client.UpdateApplication(*application); err != nil {
+		return err
+	}
+
+	applicationCacheFor(client).Invalidate()
+
+	return nil
+}
+
+func resourceNewRelicApplicationSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	application, err := findApplicationForSettings(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Adopting New Relic application %d", application.ID)
+
+	// The ID is set first so the planned state can be inspected, see
+	// applicationSettingConfigured.
+	d.SetId(strconv.Itoa(application.ID))
+
+	if err := updateApplicationSettings(client, application, d); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceNewRelicApplicationSettingsRead(d, meta)
+}
+
+func resourceNewRelicApplicationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	id, err := strconv.ParseInt(d.Id(), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading New Relic application settings %v", id)
+
+	application, err := client.GetApplication(int(id))
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("application_id", application.ID)
+	d.Set("name", application.Name)
+	d.Set("app_apdex_threshold", application.Settings.AppApdexThreshold)
+	d.Set("end_user_apdex_threshold", application.Settings.EndUserApdexThreshold)
+	d.Set("enable_real_user_monitoring", application.Settings.EnableRealUserMonitoring)
+	d.Set("use_server_side_config", application.Settings.UseServerSideConfig)
+
+	return nil
+}
+
+func resourceNewRelicApplicationSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	id, err := strconv.ParseInt(d.Id(), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	application, err := client.GetApplication(int(id))
+	if err != nil {
+		return err
+	}
+
+	if err := updateApplicationSettings(client, application, d); err != nil {
+		return err
+	}
+
+	return resourceNewRelicApplicationSettingsRead(d, meta)
+}
+
+func resourceNewRelicApplicationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// Applications are created by agents reporting data and cannot be deleted
+	// while they report, so deleting this resource only stops managing it.
+	log.Printf("[INFO] Removing New Relic application settings %s from state", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package newrelic
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func TestBuildApplicationSettingsStruct_UnsetBools(t *testing.T) {
+	current := &newrelic.Application{
+		ID:   1,
+		Name: "foo",
+		Settings: newrelic.ApplicationSettings{
+			EnableRealUserMonitoring: false,
+			UseServerSideConfig:      true,
+		},
+	}
+
+	cases := []struct {
+		raw                      map[string]interface{}
+		enableRealUserMonitoring bool
+		useServerSideConfig      bool
+	}{
+		{map[string]interface{}{"name": "foo"}, false, true},
+		{map[string]interface{}{"name": "foo", "use_server_side_config": false}, false, false},
+		{map[string]interface{}{"name": "foo", "enable_real_user_monitoring": true}, true, true},
+	}
+
+	for i, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceNewRelicApplicationSettings().Schema, tc.raw)
+		d.MarkNewResource()
+		d.SetId("1")
+
+		application := buildApplicationSettingsStruct(current, d)
+
+		if application.Settings.EnableRealUserMonitoring != tc.enableRealUserMonitoring {
+			t.Fatalf("case %d: expected enable_real_user_monitoring %v, got %v", i, tc.enableRealUserMonitoring, application.Settings.EnableRealUserMonitoring)
+		}
+
+		if application.Settings.UseServerSideConfig != tc.useServerSideConfig {
+			t.Fatalf("case %d: expected use_server_side_config %v, got %v", i, tc.useServerSideConfig, application.Settings.UseServerSideConfig)
+		}
+	}
+}
+
+func TestAccNewRelicApplicationSettings_Basic(t *testing.T) {
+	var original newrelic.Application
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSaveNewRelicApplicationSettings(t, &original)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRestoreNewRelicApplicationSettings(&original),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckNewRelicApplicationSettingsConfig("0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicApplicationSettingsApdex("newrelic_application_settings.foo", 0.5),
+					resource.TestCheckResourceAttr(
+						"newrelic_application_settings.foo", "name", testAccExpectedApplicationName),
+					resource.TestCheckResourceAttr(
+						"newrelic_application_settings.foo", "app_apdex_threshold", "0.5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckNewRelicApplicationSettingsConfig("0.7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicApplicationSettingsApdex("newrelic_application_settings.foo", 0.7),
+					resource.TestCheckResourceAttr(
+						"newrelic_application_settings.foo", "app_apdex_threshold", "0.7"),
+				),
+			},
+		},
+	})
+}
+
+// The settings resource shares the test application with other tests and
+// leaves it behind on destroy, so the original settings are restored.
+func testAccSaveNewRelicApplicationSettings(t *testing.T, original *newrelic.Application) {
+	// The provider is only configured by the first step, so configure it from
+	// the environment to read the settings before they are changed.
+	if err := testAccProvider.Configure(terraform.NewResourceConfig(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	client := testAccProvider.Meta().(*ProviderConfig).Client
+
+	name := testAccExpectedApplicationName
+	applications, err := client.QueryApplications(newrelic.ApplicationsFilters{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, application := range applications {
+		if application.Name == name {
+			*original = application
+			return
+		}
+	}
+
+	t.Fatalf("The name '%s' does not match any New Relic applications.", name)
+}
+
+func testAccRestoreNewRelicApplicationSettings(original *newrelic.Application) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ProviderConfig).Client
+
+		_, err := client.UpdateApplication(*original)
+		return err
+	}
+}
+
+func testAccCheckNewRelicApplicationSettingsApdex(n string, threshold float64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No application ID is set")
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).Client
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 32)
+		if err != nil {
+			return err
+		}
+
+		found, err := client.GetApplication(int(id))
+		if err != nil {
+			return err
+		}
+
+		if found.Settings.AppApdexThreshold != threshold {
+			return fmt.Errorf("Expected apdex threshold %v, got %v", threshold, found.Settings.AppApdexThreshold)
+		}
+
+		return nil
+	}
+}
+
+// The test application for this resource is created in provider_test.go
+func testAccCheckNewRelicApplicationSettingsConfig(threshold string) string {
+	return fmt.Sprintf(`
+resource "newrelic_application_settings" "foo" {
+  name                = "%s"
+  app_apdex_threshold = %s
+}
+`, testAccExpectedApplicationName, threshold)
+}
@@ -0,0 +1,175 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func resourceNewRelicLabel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNewRelicLabelCreate,
+		Read:   resourceNewRelicLabelRead,
+		Update: resourceNewRelicLabelUpdate,
+		Delete: resourceNewRelicLabelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Optional: true,
+			},
+			"server_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func expandIntSet(set *schema.Set) []int {
+	values := set.List()
+	result := make([]int, len(values))
+
+	for i, v := range values {
+		result[i] = v.(int)
+	}
+
+	return result
+}
+
+func buildLabelStruct(d *schema.ResourceData) *newrelic.Label {
+	label := newrelic.Label{
+		Category: d.Get("category").(string),
+		Name:     d.Get("name").(string),
+		Links: newrelic.LabelLinks{
+			Applications: expandIntSet(d.Get("application_ids").(*schema.Set)),
+			Servers:      expandIntSet(d.Get("server_ids").(*schema.Set)),
+		},
+	}
+
+	return &label
+}
+
+func labelKey(label *newrelic.Label) string {
+	return fmt.Sprintf("%s:%s", label.Category, label.Name)
+}
+
+func resourceNewRelicLabelCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+	label := buildLabelStruct(d)
+	key := labelKey(label)
+
+	log.Printf("[INFO] Creating New Relic label %s", key)
+
+	if err := client.CreateLabel(*label); err != nil {
+		return err
+	}
+
+	labelIndexFor(client).Invalidate()
+
+	d.SetId(key)
+
+	return resourceNewRelicLabelRead(d, meta)
+}
+
+func resourceNewRelicLabelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	log.Printf("[INFO] Reading New Relic label %s", d.Id())
+
+	label, err := client.GetLabel(d.Id())
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("category", label.Category)
+	d.Set("name", label.Name)
+
+	if err := d.Set("application_ids", label.Links.Applications); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting label application IDs: %#v", err)
+	}
+
+	if err := d.Set("server_ids", label.Links.Servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting label server IDs: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceNewRelicLabelUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+	label := buildLabelStruct(d)
+
+	log.Printf("[INFO] Updating New Relic label %s", d.Id())
+
+	// The API can only add links to a label, so removing a link requires
+	// recreating the label with the remaining links.
+	if labelLinksRemoved(d, "application_ids") || labelLinksRemoved(d, "server_ids") {
+		log.Printf("[INFO] Recreating New Relic label %s to remove links", d.Id())
+
+		if err := client.DeleteLabel(d.Id()); err != nil && err != newrelic.ErrNotFound {
+			return err
+		}
+
+		labelIndexFor(client).Invalidate()
+
+		// The label has no links until it is created again, and is lost if
+		// that fails; the next refresh then plans to create it.
+		if err := client.CreateLabel(*label); err != nil {
+			return fmt.Errorf("The label '%s' was deleted to remove links but could not be created again, apply again to recreate it: %s", d.Id(), err)
+		}
+	} else if err := client.CreateLabel(*label); err != nil {
+		return err
+	}
+
+	labelIndexFor(client).Invalidate()
+
+	return resourceNewRelicLabelRead(d, meta)
+}
+
+func labelLinksRemoved(d *schema.ResourceData, key string) bool {
+	if !d.HasChange(key) {
+		return false
+	}
+
+	o, n := d.GetChange(key)
+
+	return o.(*schema.Set).Difference(n.(*schema.Set)).Len() > 0
+}
+
+func resourceNewRelicLabelDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).Client
+
+	log.Printf("[INFO] Deleting New Relic label %s", d.Id())
+
+	if err := client.DeleteLabel(d.Id()); err != nil && err != newrelic.ErrNotFound {
+		return err
+	}
+
+	labelIndexFor(client).Invalidate()
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNewRelicLabel_Basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicLabelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckNewRelicLabelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicLabelExists("newrelic_label.foo"),
+					resource.TestCheckResourceAttr(
+						"newrelic_label.foo", "category", "Team"),
+					resource.TestCheckResourceAttr(
+						"newrelic_label.foo", "name", fmt.Sprintf("tf-test-%s", rName)),
+					resource.TestCheckResourceAttr(
+						"newrelic_label.foo", "application_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.newrelic_label.foo", "application_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckNewRelicLabelConfigUnlinked(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicLabelExists("newrelic_label.foo"),
+					resource.TestCheckResourceAttr(
+						"newrelic_label.foo", "application_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicLabelDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).Client
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_label" {
+			continue
+		}
+
+		_, err := client.GetLabel(r.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Label still exists")
+		}
+
+	}
+	return nil
+}
+
+func testAccCheckNewRelicLabelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No label ID is set")
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).Client
+
+		found, err := client.GetLabel(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.Key != rs.Primary.ID {
+			return fmt.Errorf("Label not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+// The test application for this resource is created in provider_test.go
+func testAccCheckNewRelicLabelConfig(rName string) string {
+	return fmt.Sprintf(`
+data "newrelic_application" "app" {
+  name = "%[1]s"
+}
+
+resource "newrelic_label" "foo" {
+  category        = "Team"
+  name            = "tf-test-%[2]s"
+  application_ids = ["${data.newrelic_application.app.id}"]
+}
+
+data "newrelic_label" "foo" {
+  key = "${newrelic_label.foo.id}"
+}
+`, testAccExpectedApplicationName, rName)
+}
+
+func testAccCheckNewRelicLabelConfigUnlinked(rName string) string {
+	return fmt.Sprintf(`
+resource "newrelic_label" "foo" {
+  category = "Team"
+  name     = "tf-test-%s"
+}
+`, rName)
+}
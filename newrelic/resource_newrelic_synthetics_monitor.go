@@ -0,0 +1,204 @@
+package newrelic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+// syntheticsMonitorTypesWithURI are the monitor types that check a URI
+// rather than run a script.
+var syntheticsMonitorTypesWithURI = []string{
+	"SIMPLE",
+	"BROWSER",
+}
+
+func resourceNewRelicSyntheticsMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNewRelicSyntheticsMonitorCreate,
+		Read:   resourceNewRelicSyntheticsMonitorRead,
+		Update: resourceNewRelicSyntheticsMonitorUpdate,
+		Delete: resourceNewRelicSyntheticsMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SIMPLE", "BROWSER", "SCRIPT_API", "SCRIPT_BROWSER"}, false),
+			},
+			"frequency": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: intInSlice([]int{1, 5, 10, 15, 30, 60, 360, 720, 1440}),
+			},
+			"locations": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MinItems: 1,
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sla_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      7.0,
+				ValidateFunc: float64Gte(0),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ENABLED",
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "MUTED", "DISABLED"}, false),
+			},
+			"validation_string": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"verify_ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func buildSyntheticsMonitorStruct(d *schema.ResourceData) (*newrelic.SyntheticsMonitor, error) {
+	monitorType := d.Get("type").(string)
+	uri := d.Get("uri").(string)
+
+	needsURI := false
+	for _, t := range syntheticsMonitorTypesWithURI {
+		if monitorType == t {
+			needsURI = true
+			break
+		}
+	}
+
+	if needsURI && uri == "" {
+		return nil, fmt.Errorf("uri is required for %s monitors", monitorType)
+	}
+
+	if !needsURI && uri != "" {
+		return nil, fmt.Errorf("uri is not supported for %s monitors", monitorType)
+	}
+
+	locations := []string{}
+	for _, l := range d.Get("locations").(*schema.Set).List() {
+		locations = append(locations, l.(string))
+	}
+
+	monitor := newrelic.SyntheticsMonitor{
+		ID:           d.Id(),
+		Name:         d.Get("name").(string),
+		Type:         monitorType,
+		Frequency:    d.Get("frequency").(int),
+		URI:          uri,
+		Locations:    locations,
+		Status:       d.Get("status").(string),
+		SLAThreshold: d.Get("sla_threshold").(float64),
+		Options: newrelic.SyntheticsMonitorOptions{
+			ValidationString: d.Get("validation_string").(string),
+			VerifySSL:        d.Get("verify_ssl").(bool),
+		},
+	}
+
+	return &monitor, nil
+}
+
+func readSyntheticsMonitorStruct(monitor *newrelic.SyntheticsMonitor, d *schema.ResourceData) error {
+	d.Set("name", monitor.Name)
+	d.Set("type", monitor.Type)
+	d.Set("frequency", monitor.Frequency)
+	d.Set("uri", monitor.URI)
+	d.Set("sla_threshold", monitor.SLAThreshold)
+	d.Set("status", monitor.Status)
+	d.Set("validation_string", monitor.Options.ValidationString)
+	d.Set("verify_ssl", monitor.Options.VerifySSL)
+
+	if err := d.Set("locations", monitor.Locations); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting synthetics monitor locations: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceNewRelicSyntheticsMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+	monitor, err := buildSyntheticsMonitorStruct(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating New Relic synthetics monitor %s", monitor.Name)
+
+	id, err := client.CreateSyntheticsMonitor(*monitor)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceNewRelicSyntheticsMonitorRead(d, meta)
+}
+
+func resourceNewRelicSyntheticsMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+
+	log.Printf("[INFO] Reading New Relic synthetics monitor %s", d.Id())
+
+	monitor, err := client.GetSyntheticsMonitor(d.Id())
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	return readSyntheticsMonitorStruct(monitor, d)
+}
+
+func resourceNewRelicSyntheticsMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+	monitor, err := buildSyntheticsMonitorStruct(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating New Relic synthetics monitor %s", d.Id())
+
+	if err := client.UpdateSyntheticsMonitor(*monitor); err != nil {
+		return err
+	}
+
+	return resourceNewRelicSyntheticsMonitorRead(d, meta)
+}
+
+func resourceNewRelicSyntheticsMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+
+	log.Printf("[INFO] Deleting New Relic synthetics monitor %s", d.Id())
+
+	if err := client.DeleteSyntheticsMonitor(d.Id()); err != nil && err != newrelic.ErrNotFound {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
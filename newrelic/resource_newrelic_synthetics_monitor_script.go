@@ -0,0 +1,132 @@
+package newrelic
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func resourceNewRelicSyntheticsMonitorScript() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNewRelicSyntheticsMonitorScriptCreate,
+		Read:   resourceNewRelicSyntheticsMonitorScriptRead,
+		Update: resourceNewRelicSyntheticsMonitorScriptUpdate,
+		Delete: resourceNewRelicSyntheticsMonitorScriptDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"monitor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"text": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"location": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"hmac": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildSyntheticsMonitorScriptStruct(d *schema.ResourceData) *newrelic.SyntheticsMonitorScript {
+	script := newrelic.SyntheticsMonitorScript{
+		Text: base64.StdEncoding.EncodeToString([]byte(d.Get("text").(string))),
+	}
+
+	for _, l := range d.Get("location").(*schema.Set).List() {
+		location := l.(map[string]interface{})
+
+		script.Locations = append(script.Locations, newrelic.SyntheticsScriptLocation{
+			Name: location["name"].(string),
+			HMAC: location["hmac"].(string),
+		})
+	}
+
+	return &script
+}
+
+func resourceNewRelicSyntheticsMonitorScriptCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+	id := d.Get("monitor_id").(string)
+
+	log.Printf("[INFO] Uploading New Relic synthetics monitor script for monitor %s", id)
+
+	if err := client.UpdateSyntheticsMonitorScript(id, *buildSyntheticsMonitorScriptStruct(d)); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceNewRelicSyntheticsMonitorScriptRead(d, meta)
+}
+
+func resourceNewRelicSyntheticsMonitorScriptRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+
+	log.Printf("[INFO] Reading New Relic synthetics monitor script for monitor %s", d.Id())
+
+	script, err := client.GetSyntheticsMonitorScript(d.Id())
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	// The script is compared decoded, so drift shows up as a text diff. The
+	// location signatures are never returned and are kept from the config.
+	text, err := base64.StdEncoding.DecodeString(script.Text)
+	if err != nil {
+		return fmt.Errorf("The script of New Relic synthetics monitor %s is not valid base64: %s", d.Id(), err)
+	}
+
+	d.Set("monitor_id", d.Id())
+	d.Set("text", string(text))
+
+	return nil
+}
+
+func resourceNewRelicSyntheticsMonitorScriptUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+
+	log.Printf("[INFO] Uploading New Relic synthetics monitor script for monitor %s", d.Id())
+
+	if err := client.UpdateSyntheticsMonitorScript(d.Id(), *buildSyntheticsMonitorScriptStruct(d)); err != nil {
+		return err
+	}
+
+	return resourceNewRelicSyntheticsMonitorScriptRead(d, meta)
+}
+
+// The script cannot be removed from a scripted monitor, so deleting the
+// resource only stops managing it; deleting the monitor deletes the script.
+func resourceNewRelicSyntheticsMonitorScriptDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Removing New Relic synthetics monitor script for monitor %s from state", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
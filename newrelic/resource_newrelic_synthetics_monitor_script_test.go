@@ -0,0 +1,84 @@
+package newrelic
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNewRelicSyntheticsMonitorScript_Basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsMonitorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckNewRelicSyntheticsMonitorScriptConfig(rName, "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorScriptText("newrelic_synthetics_monitor_script.foo", testAccSyntheticsMonitorScriptText("example.com")),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckNewRelicSyntheticsMonitorScriptConfig(rName, "example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorScriptText("newrelic_synthetics_monitor_script.foo", testAccSyntheticsMonitorScriptText("example.org")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicSyntheticsMonitorScriptText(n string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No synthetics monitor ID is set")
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).SyntheticsClient
+
+		script, err := client.GetSyntheticsMonitorScript(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		text, err := base64.StdEncoding.DecodeString(script.Text)
+		if err != nil {
+			return err
+		}
+
+		if string(text) != expected {
+			return fmt.Errorf("Expected the script to be: %q, but got: %q", expected, text)
+		}
+
+		return nil
+	}
+}
+
+func testAccSyntheticsMonitorScriptText(host string) string {
+	return fmt.Sprintf("$http.get('https://%s', function (err, response, body) {});\n", host)
+}
+
+func testAccCheckNewRelicSyntheticsMonitorScriptConfig(rName string, host string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_monitor" "foo" {
+  name      = "tf-test-%s"
+  type      = "SCRIPT_API"
+  frequency = 15
+  locations = ["AWS_US_EAST_1"]
+}
+
+resource "newrelic_synthetics_monitor_script" "foo" {
+  monitor_id = "${newrelic_synthetics_monitor.foo.id}"
+  text       = %q
+}
+`, rName, testAccSyntheticsMonitorScriptText(host))
+}
@@ -0,0 +1,100 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNewRelicSyntheticsMonitor_Basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsMonitorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckNewRelicSyntheticsMonitorConfig(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorExists("newrelic_synthetics_monitor.foo"),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_monitor.foo", "name", fmt.Sprintf("tf-test-%s", rName)),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_monitor.foo", "type", "SIMPLE"),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_monitor.foo", "locations.#", "2"),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_monitor.foo", "status", "ENABLED"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckNewRelicSyntheticsMonitorConfig(rName, "MUTED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorExists("newrelic_synthetics_monitor.foo"),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_monitor.foo", "status", "MUTED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicSyntheticsMonitorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).SyntheticsClient
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_synthetics_monitor" {
+			continue
+		}
+
+		_, err := client.GetSyntheticsMonitor(r.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Synthetics monitor still exists")
+		}
+
+	}
+	return nil
+}
+
+func testAccCheckNewRelicSyntheticsMonitorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No synthetics monitor ID is set")
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).SyntheticsClient
+
+		found, err := client.GetSyntheticsMonitor(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Synthetics monitor not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNewRelicSyntheticsMonitorConfig(rName string, status string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_monitor" "foo" {
+  name              = "tf-test-%s"
+  type              = "SIMPLE"
+  frequency         = 15
+  uri               = "https://example.com"
+  locations         = ["AWS_US_EAST_1", "AWS_EU_WEST_1"]
+  status            = "%s"
+  validation_string = "Example Domain"
+  verify_ssl        = true
+}
+`, rName, status)
+}
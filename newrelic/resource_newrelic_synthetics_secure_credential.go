@@ -0,0 +1,131 @@
+package newrelic
+
+import (
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	newrelic "github.com/paultyng/go-newrelic/api"
+)
+
+func resourceNewRelicSyntheticsSecureCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNewRelicSyntheticsSecureCredentialCreate,
+		Read:   resourceNewRelicSyntheticsSecureCredentialRead,
+		Update: resourceNewRelicSyntheticsSecureCredentialUpdate,
+		Delete: resourceNewRelicSyntheticsSecureCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: stringMatch(regexp.MustCompile("^[A-Z0-9_]{1,64}$"), "be at most 64 uppercase letters, digits or underscores"),
+			},
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildSyntheticsSecureCredentialStruct(d *schema.ResourceData) *newrelic.SyntheticsSecureCredential {
+	credential := newrelic.SyntheticsSecureCredential{
+		Key:         d.Get("key").(string),
+		Value:       d.Get("value").(string),
+		Description: d.Get("description").(string),
+	}
+
+	return &credential
+}
+
+func resourceNewRelicSyntheticsSecureCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+	credential := buildSyntheticsSecureCredentialStruct(d)
+
+	log.Printf("[INFO] Creating New Relic synthetics secure credential %s", credential.Key)
+
+	if err := client.CreateSyntheticsSecureCredential(*credential); err != nil {
+		return err
+	}
+
+	d.SetId(credential.Key)
+
+	return resourceNewRelicSyntheticsSecureCredentialRead(d, meta)
+}
+
+func resourceNewRelicSyntheticsSecureCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+
+	log.Printf("[INFO] Reading New Relic synthetics secure credential %s", d.Id())
+
+	credential, err := client.GetSyntheticsSecureCredential(d.Id())
+	if err != nil {
+		if err == newrelic.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	// The API never returns the value. If the credential was updated outside
+	// of Terraform, forget the value so the next apply writes it again.
+	if lastUpdated := d.Get("last_updated").(string); lastUpdated != "" && lastUpdated != credential.LastUpdated {
+		log.Printf("[INFO] New Relic synthetics secure credential %s was updated outside of Terraform", d.Id())
+		d.Set("value", "")
+	}
+
+	d.Set("key", credential.Key)
+	d.Set("description", credential.Description)
+	d.Set("created_at", credential.CreatedAt)
+	d.Set("last_updated", credential.LastUpdated)
+
+	return nil
+}
+
+func resourceNewRelicSyntheticsSecureCredentialUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+	credential := buildSyntheticsSecureCredentialStruct(d)
+
+	log.Printf("[INFO] Updating New Relic synthetics secure credential %s", d.Id())
+
+	if err := client.UpdateSyntheticsSecureCredential(*credential); err != nil {
+		return err
+	}
+
+	// The update itself changes last_updated, which is not drift.
+	d.Set("last_updated", "")
+
+	return resourceNewRelicSyntheticsSecureCredentialRead(d, meta)
+}
+
+func resourceNewRelicSyntheticsSecureCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderConfig).SyntheticsClient
+
+	log.Printf("[INFO] Deleting New Relic synthetics secure credential %s", d.Id())
+
+	if err := client.DeleteSyntheticsSecureCredential(d.Id()); err != nil && err != newrelic.ErrNotFound {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNewRelicSyntheticsSecureCredential_Basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsSecureCredentialDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckNewRelicSyntheticsSecureCredentialConfig(rName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsSecureCredentialExists("newrelic_synthetics_secure_credential.foo"),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_secure_credential.foo", "key", fmt.Sprintf("TF_TEST_%s", rName)),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_secure_credential.foo", "description", "foo"),
+					resource.TestCheckResourceAttrSet(
+						"newrelic_synthetics_secure_credential.foo", "last_updated"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckNewRelicSyntheticsSecureCredentialConfig(rName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsSecureCredentialExists("newrelic_synthetics_secure_credential.foo"),
+					resource.TestCheckResourceAttr(
+						"newrelic_synthetics_secure_credential.foo", "description", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicSyntheticsSecureCredentialDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).SyntheticsClient
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_synthetics_secure_credential" {
+			continue
+		}
+
+		_, err := client.GetSyntheticsSecureCredential(r.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Synthetics secure credential still exists")
+		}
+
+	}
+	return nil
+}
+
+func testAccCheckNewRelicSyntheticsSecureCredentialExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No synthetics secure credential key is set")
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).SyntheticsClient
+
+		found, err := client.GetSyntheticsSecureCredential(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.Key != rs.Primary.ID {
+			return fmt.Errorf("Synthetics secure credential not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNewRelicSyntheticsSecureCredentialConfig(rName string, description string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_secure_credential" "foo" {
+  key         = "TF_TEST_%s"
+  value       = "secret"
+  description = "%s"
+}
+`, rName, description)
+}
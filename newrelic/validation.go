@@ -2,6 +2,7 @@ package newrelic
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -41,3 +42,33 @@ func intInSlice(valid []int) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+func validateRegexp(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := regexp.Compile(v); err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a valid regular expression, got %v: %s", k, v, err))
+	}
+
+	return
+}
+
+func stringMatch(r *regexp.Regexp, message string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if !r.MatchString(v) {
+			es = append(es, fmt.Errorf("expected %s to %s, got %v", k, message, v))
+		}
+
+		return
+	}
+}
@@ -55,6 +55,44 @@ func TestValidationFloat64Gte(t *testing.T) {
 	})
 }
 
+func TestValidationRegexp(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "^foo-.*$",
+			f:   validateRegexp,
+		},
+		{
+			val:         "foo(",
+			f:           validateRegexp,
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be a valid regular expression"),
+		},
+		{
+			val:         1,
+			f:           validateRegexp,
+			expectedErr: regexp.MustCompile("expected type of [\\w]+ to be string"),
+		},
+	})
+}
+
+func TestValidationStringMatch(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "FOO_1",
+			f:   stringMatch(regexp.MustCompile("^[A-Z0-9_]+$"), "contain only uppercase letters, digits and underscores"),
+		},
+		{
+			val:         "foo",
+			f:           stringMatch(regexp.MustCompile("^[A-Z0-9_]+$"), "contain only uppercase letters, digits and underscores"),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to contain only uppercase letters, digits and underscores, got foo"),
+		},
+		{
+			val:         1,
+			f:           stringMatch(regexp.MustCompile("^[A-Z0-9_]+$"), "contain only uppercase letters, digits and underscores"),
+			expectedErr: regexp.MustCompile("expected type of [\\w]+ to be string"),
+		},
+	})
+}
+
 func runTestCases(t *testing.T, cases []testCase) {
 	matchErr := func(errs []error, r *regexp.Regexp) bool {
 		// err must match one provided